@@ -0,0 +1,98 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"strings"
+)
+
+// daProvider is a data availability layer which RollApps can use.
+// Adding a new DA layer only requires implementing this interface and registering it into daProviders.
+type daProvider interface {
+	// name is the value of the "da" field of chain definitions using the DA layer
+	name() string
+
+	// fields returns the JSON field names of the chain definition which are specific to the DA layer,
+	// those fields are not allowed for RollApps using other DA layers.
+	fields() []string
+
+	// isValidFields validates the DA-specific fields of the chain definition
+	isValidFields(cd valtypes.ChainDefinition) bool
+}
+
+// daProviders is the list of supported DA layers
+var daProviders = []daProvider{
+	availDaProvider{},
+	celestiaDaProvider{},
+	localDaProvider{},
+}
+
+func daProviderByName(name string) (daProvider, bool) {
+	for _, provider := range daProviders {
+		if provider.name() == name {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
+// isDAAllowedInGroup returns true if the DA layer is not forbidden by the group policy,
+// e.g. local DA is forbidden by the default policy of mainnet.
+func isDAAllowedInGroup(provider daProvider, policy valtypes.GroupPolicy) bool {
+	for _, forbiddenDA := range policy.ForbiddenDA {
+		if forbiddenDA == provider.name() {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidDA(cd valtypes.ChainDefinition, target valtypes.ValidateTarget, policy valtypes.GroupPolicy) bool {
+	if !cd.IsRollAppChain() || cd.DA == "" {
+		// presence is checked by the chain type requirements
+		return true
+	}
+
+	provider, found := daProviderByName(cd.DA)
+	if !found {
+		names := make([]string, len(daProviders))
+		for i, provider := range daProviders {
+			names[i] = fmt.Sprintf("'%s'", provider.name())
+		}
+		utils.PrintlnStdErr("ERR: DA must be one of:", strings.Join(names, ", "))
+		return false
+	}
+
+	if !isDAAllowedInGroup(provider, policy) {
+		utils.PrintlnStdErr("ERR: DA", provider.name(), "is not allowed for group", target.String())
+		return false
+	}
+
+	return true
+}
+
+// isValidDAFields ensures DA-specific fields are only used along with the DA layer they belong to,
+// regardless of the chain type, and validates them by the DA layer of the chain.
+// It is not used for chain types which forbid DA, see isDAForbiddenByChainType.
+func isValidDAFields(cd valtypes.ChainDefinition) bool {
+	provider, found := daProviderByName(cd.DA)
+
+	for _, other := range daProviders {
+		if found && other.name() == provider.name() {
+			continue
+		}
+		for _, field := range other.fields() {
+			if cd.HasField(field) {
+				utils.PrintlnStdErr("ERR: Field", field, "is only available if DA is", other.name())
+				return false
+			}
+		}
+	}
+
+	if !found {
+		return true
+	}
+
+	return provider.isValidFields(cd)
+}
@@ -0,0 +1,52 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"strings"
+)
+
+// availSS58NetworkPrefix is the SS58 network prefix of Avail addresses, addresses start with "5"
+const availSS58NetworkPrefix = 42
+
+var _ daProvider = availDaProvider{}
+
+// availDaProvider is the Avail DA layer, RollApps can provide the Avail address used to post data
+// and mark they are using the Goldberg testnet.
+type availDaProvider struct{}
+
+func (availDaProvider) name() string {
+	return "Avail"
+}
+
+func (availDaProvider) fields() []string {
+	return []string{"availAddress", "goldberg"}
+}
+
+func (availDaProvider) isValidFields(cd valtypes.ChainDefinition) bool {
+	if cd.AvailAddress != "" && !isValidAvailAddress(cd.AvailAddress) {
+		utils.PrintlnStdErr("ERR: Bad avail address:", cd.AvailAddress)
+		return false
+	}
+	return true
+}
+
+func isValidAvailAddress(availAddress string) bool {
+	if strings.Contains(availAddress, " ") {
+		utils.PrintlnStdErr("ERR: Avail address must not contains space")
+		return false
+	}
+
+	networkPrefix, _, err := utils.DecodeSS58(availAddress)
+	if err != nil {
+		utils.PrintlnStdErr("ERR: Avail address is not a valid SS58 address:", err)
+		return false
+	}
+
+	if networkPrefix != availSS58NetworkPrefix {
+		utils.PrintlnStdErr("ERR: Avail address must use SS58 network prefix", availSS58NetworkPrefix, "got", networkPrefix)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,20 @@
+package dymension_chain_registry
+
+import valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+
+var _ daProvider = celestiaDaProvider{}
+
+// celestiaDaProvider is the Celestia DA layer, it has no specific fields.
+type celestiaDaProvider struct{}
+
+func (celestiaDaProvider) name() string {
+	return "Celestia"
+}
+
+func (celestiaDaProvider) fields() []string {
+	return nil
+}
+
+func (celestiaDaProvider) isValidFields(valtypes.ChainDefinition) bool {
+	return true
+}
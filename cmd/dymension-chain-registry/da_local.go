@@ -0,0 +1,21 @@
+package dymension_chain_registry
+
+import valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+
+var _ daProvider = localDaProvider{}
+
+// localDaProvider is the local DA used by development RollApps, data is not posted to any DA network
+// so it is forbidden by the default policy of mainnet.
+type localDaProvider struct{}
+
+func (localDaProvider) name() string {
+	return "local"
+}
+
+func (localDaProvider) fields() []string {
+	return nil
+}
+
+func (localDaProvider) isValidFields(valtypes.ChainDefinition) bool {
+	return true
+}
@@ -0,0 +1,53 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_daProviderByName(t *testing.T) {
+	for _, name := range []string{"Avail", "Celestia", "local"} {
+		provider, found := daProviderByName(name)
+		require.True(t, found, name)
+		require.Equal(t, name, provider.name())
+	}
+
+	for _, name := range []string{"", "celestia", "Local", "EigenDA"} {
+		_, found := daProviderByName(name)
+		require.False(t, found, name)
+	}
+}
+
+func Test_isValidDA(t *testing.T) {
+	policies := valtypes.DefaultGroupPolicies()
+	rollApp := func(da string) valtypes.ChainDefinition {
+		return valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), DA: da}
+	}
+
+	tests := []struct {
+		name   string
+		cd     valtypes.ChainDefinition
+		target valtypes.ValidateTarget
+		policy valtypes.GroupPolicy
+		want   bool
+	}{
+		{name: "Celestia on mainnet", cd: rollApp("Celestia"), target: valtypes.ValidateMainnet, policy: policies[valtypes.ValidateMainnet], want: true},
+		{name: "Avail on mainnet", cd: rollApp("Avail"), target: valtypes.ValidateMainnet, policy: policies[valtypes.ValidateMainnet], want: true},
+		{name: "local on mainnet", cd: rollApp("local"), target: valtypes.ValidateMainnet, policy: policies[valtypes.ValidateMainnet], want: false},
+		{name: "local on testnet", cd: rollApp("local"), target: valtypes.ValidateTestnet, policy: policies[valtypes.ValidateTestnet], want: true},
+		{name: "local on group without policy", cd: rollApp("local"), target: "playground", policy: valtypes.GroupPolicy{}, want: true},
+		{name: "local on mainnet with policy not forbidding it", cd: rollApp("local"), target: valtypes.ValidateMainnet, policy: valtypes.GroupPolicy{}, want: true},
+		{name: "forbidden by group policy", cd: rollApp("Avail"), target: valtypes.ValidateTestnet, policy: valtypes.GroupPolicy{ForbiddenDA: []string{"Avail"}}, want: false},
+		{name: "other DA forbidden by group policy", cd: rollApp("Celestia"), target: valtypes.ValidateTestnet, policy: valtypes.GroupPolicy{ForbiddenDA: []string{"Avail"}}, want: true},
+		{name: "unknown DA", cd: rollApp("EigenDA"), target: valtypes.ValidateTestnet, policy: valtypes.GroupPolicy{}, want: false},
+		{name: "DA name is case-sensitive", cd: rollApp("celestia"), target: valtypes.ValidateTestnet, policy: valtypes.GroupPolicy{}, want: false},
+		{name: "RollApp without DA, checked by chain type requirements", cd: rollApp(""), target: valtypes.ValidateMainnet, policy: policies[valtypes.ValidateMainnet], want: true},
+		{name: "non-RollApp, checked by chain type requirements", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeHub), DA: "local"}, target: valtypes.ValidateMainnet, policy: policies[valtypes.ValidateMainnet], want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isValidDA(tt.cd, tt.target, tt.policy))
+		})
+	}
+}
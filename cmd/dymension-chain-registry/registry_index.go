@@ -0,0 +1,221 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"strings"
+)
+
+// registryIndex holds chain definitions collected while walking a group,
+// used by the checks which need to look across chains of the group.
+type registryIndex struct {
+	chains []*indexedChain
+
+	// cachedAssetGroups and cachedAssetGroupOf are computed on first access of assetGroups,
+	// the index must not be modified after that
+	cachedAssetGroups  []*assetGroup
+	cachedAssetGroupOf map[currencyKey]*assetGroup
+}
+
+// currencyKey identifies a currency of an indexed chain
+type currencyKey struct {
+	chain     *indexedChain
+	baseDenom string
+}
+
+// indexedChain is a chain definition which was loaded successfully during validation of a group
+type indexedChain struct {
+	dirName             string
+	chainPath           string
+	chainDefinitionFile string
+	cd                  valtypes.ChainDefinition
+}
+
+// indexedCurrency is a currency of an indexed chain
+type indexedCurrency struct {
+	chain    *indexedChain
+	currency valtypes.CurrencyChainDefinition
+}
+
+// assetGroup is the set of currencies across chains which represent the same asset,
+// linked by IBC representation or bridge denom.
+type assetGroup struct {
+	members []indexedCurrency
+}
+
+// registryIssue is an issue found by a registry-wide check, reported against the chain it belongs to
+type registryIssue struct {
+	chain   *indexedChain
+	message []any
+}
+
+func (ri *registryIndex) add(dirName, chainPath, chainDefinitionFile string, cd valtypes.ChainDefinition) {
+	ri.chains = append(ri.chains, &indexedChain{
+		dirName:             dirName,
+		chainPath:           chainPath,
+		chainDefinitionFile: chainDefinitionFile,
+		cd:                  cd,
+	})
+}
+
+// mainCurrency returns the currency of type main of the chain
+func (ic *indexedChain) mainCurrency() (valtypes.CurrencyChainDefinition, bool) {
+	for _, currency := range ic.cd.Currencies {
+		if currency.Type == "main" {
+			return currency, true
+		}
+	}
+	return valtypes.CurrencyChainDefinition{}, false
+}
+
+// currencies returns all currencies of all indexed chains
+func (ri *registryIndex) currencies() (currencies []indexedCurrency) {
+	for _, chain := range ri.chains {
+		for _, currency := range chain.cd.Currencies {
+			currencies = append(currencies, indexedCurrency{chain: chain, currency: currency})
+		}
+	}
+	return
+}
+
+// assetGroups links the currencies which represent the same asset across chains.
+// Currencies are linked when they share the same IBC representation, when the base denom of one is the
+// IBC representation of another, when the base denom of one is the IBC voucher of a hub currency over
+// the IBC channel of the chain, when they share the same bridge denom, or when the bridge denom of one is
+// the contract address (EVM) or mint address (Solana) of another.
+// Currencies which are not linked to any other currency are returned as single-member groups.
+func (ri *registryIndex) assetGroups() []*assetGroup {
+	if ri.cachedAssetGroups != nil {
+		return ri.cachedAssetGroups
+	}
+
+	currencies := ri.currencies()
+
+	parent := make([]int, len(currencies))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	linkKeyOwner := make(map[string]int)
+	link := func(i int, key string) {
+		if owner, found := linkKeyOwner[key]; found {
+			parent[find(i)] = find(owner)
+		} else {
+			linkKeyOwner[key] = i
+		}
+	}
+
+	for i, c := range currencies {
+		if c.currency.IbcRepresentation != "" {
+			link(i, "ibc:"+c.currency.IbcRepresentation)
+		}
+		if strings.HasPrefix(c.currency.BaseDenom, "ibc/") {
+			link(i, "ibc:"+c.currency.BaseDenom)
+		}
+		if c.currency.BridgeDenom != "" {
+			link(i, "bridge:"+normalizeBridgeDenom(c.currency.BridgeDenom))
+		}
+		if bridgedDenom, found := c.bridgedDenom(); found {
+			link(i, "bridge:"+normalizeBridgeDenom(bridgedDenom))
+		}
+	}
+
+	// currencies of the hub are received by chains connected to the hub as IBC vouchers, e.g. DYM on RollApps,
+	// the voucher denom is computed from the channel of the connected chain.
+	for i, c := range currencies {
+		if c.chain.cd.Type != string(valtypes.ChainTypeHub) || strings.HasPrefix(c.currency.BaseDenom, "ibc/") {
+			continue
+		}
+		for _, chain := range ri.chains {
+			if chain == c.chain || chain.cd.IBC == nil || chain.cd.IBC.Channel == "" {
+				continue
+			}
+			link(i, "ibc:"+utils.IbcDenom("transfer", chain.cd.IBC.Channel, c.currency.BaseDenom))
+		}
+	}
+
+	groupByRoot := make(map[int]*assetGroup)
+	groupOf := make(map[currencyKey]*assetGroup)
+	var groups []*assetGroup
+	for i, c := range currencies {
+		root := find(i)
+		group, found := groupByRoot[root]
+		if !found {
+			group = &assetGroup{}
+			groupByRoot[root] = group
+			groups = append(groups, group)
+		}
+		group.members = append(group.members, c)
+		groupOf[currencyKey{chain: c.chain, baseDenom: c.currency.BaseDenom}] = group
+	}
+
+	ri.cachedAssetGroups = groups
+	ri.cachedAssetGroupOf = groupOf
+	return groups
+}
+
+// assetGroupOf returns the asset group which the currency of the chain belongs to
+func (ri *registryIndex) assetGroupOf(chain *indexedChain, baseDenom string) *assetGroup {
+	ri.assetGroups()
+	return ri.cachedAssetGroupOf[currencyKey{chain: chain, baseDenom: baseDenom}]
+}
+
+// origin returns the currency of the chain which the asset is native to, that is the only member which base denom
+// is not an IBC denom. When there are multiple, e.g. bridged assets where the bridged currency is not an IBC denom,
+// it is the only member of EVM or Solana chain which base denom is the bridge denom of another member.
+// Returns false if it could not be determined.
+func (ag *assetGroup) origin() (indexedCurrency, bool) {
+	var nonIbcMembers []indexedCurrency
+	bridgeDenoms := make(map[string]bool)
+	for _, member := range ag.members {
+		if member.currency.BridgeDenom != "" {
+			bridgeDenoms[normalizeBridgeDenom(member.currency.BridgeDenom)] = true
+		}
+		if strings.HasPrefix(member.currency.BaseDenom, "ibc/") {
+			continue
+		}
+		nonIbcMembers = append(nonIbcMembers, member)
+	}
+	if len(nonIbcMembers) == 1 {
+		return nonIbcMembers[0], true
+	}
+
+	var origin indexedCurrency
+	var count int
+	for _, member := range nonIbcMembers {
+		if bridgedDenom, found := member.bridgedDenom(); found && bridgeDenoms[normalizeBridgeDenom(bridgedDenom)] {
+			origin = member
+			count++
+		}
+	}
+	return origin, count == 1
+}
+
+// bridgedDenom returns the denom which currencies of other chains refer to as bridge denom,
+// that is the contract address of currency of EVM chain, or the mint address of currency of Solana chain.
+func (ic indexedCurrency) bridgedDenom() (string, bool) {
+	switch valtypes.ChainType(ic.chain.cd.Type) {
+	case valtypes.ChainTypeEVM:
+		return evmContractAddressOfDenom(ic.currency.BaseDenom, ic.chain.cd, valtypes.ChainTypeEVM)
+	case valtypes.ChainTypeSolana:
+		return ic.currency.BaseDenom, ic.currency.BaseDenom != ""
+	default:
+		return "", false
+	}
+}
+
+// normalizeBridgeDenom returns the form of the bridge denom used for comparison,
+// EVM contract addresses are case-insensitive.
+func normalizeBridgeDenom(bridgeDenom string) string {
+	if strings.HasPrefix(bridgeDenom, "0x") {
+		return strings.ToLower(bridgeDenom)
+	}
+	return bridgeDenom
+}
@@ -0,0 +1,128 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_registryIndex_assetGroups(t *testing.T) {
+	const (
+		usdcContract = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+		usdcMint     = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+		ibcOfRollApp = "ibc/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+	)
+
+	index := &registryIndex{}
+	index.add("ethereum", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeEVM),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "USDC", BaseDenom: usdcContract, Decimals: 6, Type: "regular"},
+			{DisplayDenom: "ETH", BaseDenom: "wei", Decimals: 18, Type: "main"},
+		},
+	})
+	index.add("solana", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeSolana),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "USDC", BaseDenom: usdcMint, Decimals: 6, Type: "regular"},
+		},
+	})
+	index.add("rollapp1", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeRollApp),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "RA", BaseDenom: "ara", IbcRepresentation: ibcOfRollApp, Decimals: 18, Type: "main"},
+			// bridge denom in lowercase, EVM contract addresses are case-insensitive
+			{DisplayDenom: "USDC", BaseDenom: "hyperlane/0x01", BridgeDenom: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", Decimals: 18, Type: "regular"},
+			{DisplayDenom: "USDC.sol", BaseDenom: "hyperlane/0x02", BridgeDenom: usdcMint, Decimals: 6, Type: "regular"},
+		},
+	})
+	index.add("dymension", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeHub),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "DYM", BaseDenom: "adym", Decimals: 18, Type: "main"},
+			{DisplayDenom: "RA", BaseDenom: ibcOfRollApp, Decimals: 18, Type: "regular"},
+		},
+	})
+	chainOf := func(dirName string) *indexedChain {
+		for _, chain := range index.chains {
+			if chain.dirName == dirName {
+				return chain
+			}
+		}
+		t.Fatalf("chain %s not found", dirName)
+		return nil
+	}
+
+	t.Run("linked by bridge denom and contract address", func(t *testing.T) {
+		group := index.assetGroupOf(chainOf("ethereum"), usdcContract)
+		require.NotNil(t, group)
+		require.Same(t, group, index.assetGroupOf(chainOf("rollapp1"), "hyperlane/0x01"))
+		require.Len(t, group.members, 2)
+
+		origin, found := group.origin()
+		require.True(t, found)
+		require.Equal(t, "ethereum", origin.chain.dirName)
+		require.Equal(t, usdcContract, origin.currency.BaseDenom)
+	})
+
+	t.Run("linked by bridge denom and mint address", func(t *testing.T) {
+		group := index.assetGroupOf(chainOf("solana"), usdcMint)
+		require.NotNil(t, group)
+		require.Same(t, group, index.assetGroupOf(chainOf("rollapp1"), "hyperlane/0x02"))
+
+		origin, found := group.origin()
+		require.True(t, found)
+		require.Equal(t, "solana", origin.chain.dirName)
+	})
+
+	t.Run("linked by IBC representation", func(t *testing.T) {
+		group := index.assetGroupOf(chainOf("rollapp1"), "ara")
+		require.NotNil(t, group)
+		require.Same(t, group, index.assetGroupOf(chainOf("dymension"), ibcOfRollApp))
+
+		origin, found := group.origin()
+		require.True(t, found)
+		require.Equal(t, "rollapp1", origin.chain.dirName)
+	})
+
+	t.Run("not linked", func(t *testing.T) {
+		group := index.assetGroupOf(chainOf("ethereum"), "wei")
+		require.NotNil(t, group)
+		require.Len(t, group.members, 1)
+		require.NotSame(t, group, index.assetGroupOf(chainOf("dymension"), "adym"))
+
+		require.Nil(t, index.assetGroupOf(chainOf("ethereum"), "unknown"))
+	})
+
+	t.Run("decimals mismatch of bridged asset", func(t *testing.T) {
+		issues := findDecimalsMismatches(index)
+		require.Len(t, issues, 1)
+		require.Equal(t, "rollapp1", issues[0].chain.dirName)
+		require.Contains(t, issues[0].message[0], "currency USDC (hyperlane/0x01) has decimals 18")
+		require.Contains(t, issues[0].message[0], "on its origin chain ethereum has decimals 6")
+	})
+}
+
+func Test_assetGroup_origin_Ambiguous(t *testing.T) {
+	ethereum := &indexedChain{dirName: "ethereum", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeEVM)}}
+	rollApp1 := &indexedChain{dirName: "rollapp1", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp)}}
+	rollApp2 := &indexedChain{dirName: "rollapp2", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp)}}
+
+	t.Run("multiple non-IBC members without bridged origin", func(t *testing.T) {
+		group := &assetGroup{members: []indexedCurrency{
+			{chain: rollApp1, currency: valtypes.CurrencyChainDefinition{BaseDenom: "hyperlane/0x01", BridgeDenom: "0x1111111111111111111111111111111111111111"}},
+			{chain: rollApp2, currency: valtypes.CurrencyChainDefinition{BaseDenom: "hyperlane/0x02", BridgeDenom: "0x1111111111111111111111111111111111111111"}},
+		}}
+		_, found := group.origin()
+		require.False(t, found)
+	})
+
+	t.Run("EVM member which is not the bridged contract", func(t *testing.T) {
+		group := &assetGroup{members: []indexedCurrency{
+			{chain: ethereum, currency: valtypes.CurrencyChainDefinition{BaseDenom: "0x2222222222222222222222222222222222222222", BridgeDenom: "0x1111111111111111111111111111111111111111"}},
+			{chain: rollApp1, currency: valtypes.CurrencyChainDefinition{BaseDenom: "hyperlane/0x01", BridgeDenom: "0x1111111111111111111111111111111111111111"}},
+		}}
+		_, found := group.origin()
+		require.False(t, found)
+	})
+}
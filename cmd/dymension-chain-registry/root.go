@@ -14,6 +14,7 @@ func Commands() *cobra.Command {
 
 	cmd.AddCommand(
 		GetValidateCommand(),
+		GetRulesCommand(),
 	)
 
 	return cmd
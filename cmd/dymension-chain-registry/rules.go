@@ -0,0 +1,94 @@
+package dymension_chain_registry
+
+import (
+	"encoding/json"
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"sort"
+)
+
+// effectiveGroupRules is the rule set which is applied when validating a group
+type effectiveGroupRules struct {
+	Group     string               `json:"group"`
+	Policy    valtypes.GroupPolicy `json:"policy"`
+	AllowedDA []string             `json:"allowedDa"`
+	Rules     valtypes.RuleConfig  `json:"rules"`
+}
+
+func GetRulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rules [repo-dir]",
+		Short: "Print the effective validation rules per group",
+		Long:  "Print the effective validation rules per group, groups are read from the registry when repo-dir provided",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ruleConfig := loadRuleConfigFromFlag(cmd)
+
+			var availableGroups []valtypes.ValidateTarget
+			var declared bool
+			if len(args) > 0 {
+				var err error
+				availableGroups, declared, err = valtypes.LoadRegistryGroups(args[0])
+				if err != nil {
+					utils.PrintlnStdErr("ERR: Failed to load groups of the registry:", err)
+					os.Exit(1)
+				}
+			} else {
+				availableGroups = valtypes.BuiltInValidateTargets()
+				isBuiltIn := make(map[valtypes.ValidateTarget]bool)
+				for _, group := range availableGroups {
+					isBuiltIn[group] = true
+				}
+				var ruleConfigGroups []valtypes.ValidateTarget
+				for group := range ruleConfig.Groups {
+					if !isBuiltIn[group] {
+						ruleConfigGroups = append(ruleConfigGroups, group)
+					}
+				}
+				sort.Slice(ruleConfigGroups, func(i, j int) bool {
+					return ruleConfigGroups[i] < ruleConfigGroups[j]
+				})
+				availableGroups = append(availableGroups, ruleConfigGroups...)
+			}
+
+			// the group policy is printed separately
+			rules := ruleConfig
+			rules.Groups = nil
+
+			var effectiveRules []effectiveGroupRules
+			for _, target := range getTargetsFromFlags(cmd, availableGroups, declared) {
+				policy := ruleConfig.GroupPolicy(target)
+
+				allowedDA := make([]string, 0, len(daProviders))
+				for _, provider := range daProviders {
+					if isDAAllowedInGroup(provider, policy) {
+						allowedDA = append(allowedDA, provider.name())
+					}
+				}
+
+				effectiveRules = append(effectiveRules, effectiveGroupRules{
+					Group:     string(target),
+					Policy:    policy,
+					AllowedDA: allowedDA,
+					Rules:     rules,
+				})
+			}
+
+			bz, err := json.MarshalIndent(effectiveRules, "", "  ")
+			if err != nil {
+				utils.PrintlnStdErr("ERR: Failed to marshal rules:", err)
+				os.Exit(1)
+			}
+
+			fmt.Println(string(bz))
+		},
+	}
+
+	addTargetFlags(cmd, "print rules of")
+	cmd.Flags().String(flagRuleConfig, "", "path to the JSON file which overrides the default validation rules")
+
+	return cmd
+}
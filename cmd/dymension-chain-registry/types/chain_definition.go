@@ -2,15 +2,18 @@ package types
 
 import (
 	"fmt"
+	"golang.org/x/text/unicode/norm"
+	"regexp"
 	"strings"
+	"unicode"
 )
 
 type ChainDefinition struct {
 	ChainId       string                        `json:"chainId"`
 	ChainName     string                        `json:"chainName"`
-	RpcUrls       any                           `json:"rpc"`
-	RestUrls      any                           `json:"rest"`
-	BeRpcUrls     any                           `json:"beRpc"`
+	RpcUrls       UrlList                       `json:"rpc"`
+	RestUrls      UrlList                       `json:"rest"`
+	BeRpcUrls     UrlList                       `json:"beRpc"`
 	Bech32Prefix  string                        `json:"bech32Prefix"`
 	WebSite       string                        `json:"website,omitempty"`
 	DA            string                        `json:"da"`
@@ -31,8 +34,8 @@ type ChainDefinition struct {
 }
 
 type EvmChainDefinition struct {
-	ChainId string `json:"chainId"`
-	RpcUrls any    `json:"rpc"`
+	ChainId string  `json:"chainId"`
+	RpcUrls UrlList `json:"rpc"`
 }
 
 type CurrencyChainDefinition struct {
@@ -61,22 +64,22 @@ type GasPriceStepsChainDefinition struct {
 }
 
 func (cd ChainDefinition) GetRpcUrls() ([]string, error) {
-	return getUrlsFromDynamicValue(cd.RpcUrls)
+	return cd.RpcUrls.Urls(), cd.RpcUrls.Err()
 }
 
 func (cd ChainDefinition) GetRestUrls() ([]string, error) {
-	return getUrlsFromDynamicValue(cd.RestUrls)
+	return cd.RestUrls.Urls(), cd.RestUrls.Err()
 }
 
 func (cd ChainDefinition) GetBeRpcUrls() ([]string, error) {
-	return getUrlsFromDynamicValue(cd.BeRpcUrls)
+	return cd.BeRpcUrls.Urls(), cd.BeRpcUrls.Err()
 }
 
 func (cd ChainDefinition) GetEvmRpcUrls() ([]string, error) {
 	if cd.EVM == nil {
 		return nil, fmt.Errorf("EVM chain definition is not set")
 	}
-	return getUrlsFromDynamicValue(cd.EVM.RpcUrls)
+	return cd.EVM.RpcUrls.Urls(), cd.EVM.RpcUrls.Err()
 }
 
 func (cd ChainDefinition) IsRollAppChain() bool {
@@ -91,24 +94,28 @@ func (cd ChainDefinition) IsDaAvail() bool {
 	return strings.EqualFold(cd.DA, "Avail")
 }
 
-func getUrlsFromDynamicValue(dynamicValue any) (urls []string, err error) {
-	if dynamicValue != nil {
-		if strRpcUrl, ok := dynamicValue.(string); ok {
-			urls = []string{strRpcUrl}
-		} else if strRpcUrls, ok := dynamicValue.([]any); ok {
-			for _, url := range strRpcUrls {
-				if strUrl, ok := url.(string); ok {
-					urls = append(urls, strUrl)
-				} else {
-					err = fmt.Errorf("url must be string, got %T", url)
-					return
-				}
+// ChainIdPrefix returns the chain id without the EVM chain id and revision parts,
+// e.g. "dymension" for "dymension_1100-1" and "cosmoshub" for "cosmoshub-4".
+func (cd ChainDefinition) ChainIdPrefix() string {
+	return ParseChainId(cd.ChainId).Name
+}
+
+// ChainNameSlug returns the lowercase form of the chain name where diacritics of Latin letters are removed and each run
+// of characters other than letters and digits is replaced by a single dash, e.g. "dymension-hub" for "Dymension Hub",
+// "unicode-chain" for "Ünïcode Chain". Letters of other scripts are kept as is.
+func (cd ChainDefinition) ChainNameSlug() string {
+	var sb strings.Builder
+	var isLatinBase bool
+	for _, r := range norm.NFKD.String(cd.ChainName) {
+		if unicode.Is(unicode.Mn, r) {
+			if isLatinBase {
+				continue
 			}
 		} else {
-			err = fmt.Errorf("url must be string or []string, got %T", dynamicValue)
-			return
+			isLatinBase = unicode.Is(unicode.Latin, r)
 		}
+		sb.WriteRune(unicode.ToLower(r))
 	}
-
-	return
+	slug := regexp.MustCompile(`[^\p{L}\p{Nd}]+`).ReplaceAllString(norm.NFC.String(sb.String()), "-")
+	return strings.Trim(slug, "-")
 }
@@ -82,3 +82,46 @@ func Test_GetXUrls(t *testing.T) {
 		})
 	}
 }
+
+func TestChainDefinition_ChainIdPrefix(t *testing.T) {
+	tests := []struct {
+		chainId string
+		want    string
+	}{
+		{chainId: "dymension_1100-1", want: "dymension"},
+		{chainId: "cosmoshub-4", want: "cosmoshub"},
+		{chainId: "osmo-test-5", want: "osmo-test"},
+		{chainId: "ethereum", want: "ethereum"},
+		{chainId: "mocha-testnet", want: "mocha-testnet"},
+		{chainId: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chainId, func(t *testing.T) {
+			require.Equal(t, tt.want, ChainDefinition{ChainId: tt.chainId}.ChainIdPrefix())
+		})
+	}
+}
+
+func TestChainDefinition_ChainNameSlug(t *testing.T) {
+	tests := []struct {
+		chainName string
+		want      string
+	}{
+		{chainName: "Dymension Hub", want: "dymension-hub"},
+		{chainName: "dymension", want: "dymension"},
+		{chainName: "  Foo  --  Bar!  ", want: "foo-bar"},
+		{chainName: "Foo_Bar 2", want: "foo-bar-2"},
+		{chainName: "Ünïcode Chain", want: "unicode-chain"},
+		{chainName: "Cafe\u0301 Chain", want: "cafe-chain"},
+		{chainName: "ドラゴン Chain", want: "ドラゴン-chain"},
+		{chainName: "한국 Chain", want: "한국-chain"},
+		{chainName: "Ｆｕｌｌｗｉｄｔｈ", want: "fullwidth"},
+		{chainName: "!!!", want: ""},
+		{chainName: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chainName, func(t *testing.T) {
+			require.Equal(t, tt.want, ChainDefinition{ChainName: tt.chainName}.ChainNameSlug())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// ChainIdParts is the structured form of a chain id, which can be one of:
+//   - <name>, e.g. "ethereum"
+//   - <name>-<revision>, e.g. "cosmoshub-4"
+//   - <name>_<evm-chain-id>-<revision>, e.g. "dymension_1100-1"
+type ChainIdParts struct {
+	Name string
+	// EvmChainId is the decimal EVM chain id part, empty if not present
+	EvmChainId string
+	// Revision is the decimal revision part, empty if not present
+	Revision string
+}
+
+// ParseChainId splits the chain id into its parts. The parts are not validated, see ChainIdParts.Validate.
+func ParseChainId(chainId string) ChainIdParts {
+	if match := regexp.MustCompile(`^([a-z\d]+)_(\d+)-(\d+)$`).FindStringSubmatch(chainId); match != nil {
+		return ChainIdParts{Name: match[1], EvmChainId: match[2], Revision: match[3]}
+	}
+	if match := regexp.MustCompile(`^(.+)-(\d+)$`).FindStringSubmatch(chainId); match != nil {
+		return ChainIdParts{Name: match[1], Revision: match[2]}
+	}
+	return ChainIdParts{Name: chainId}
+}
+
+// HasRevision returns true if the chain id has the revision part
+func (p ChainIdParts) HasRevision() bool {
+	return p.Revision != ""
+}
+
+// RevisionNumber returns the revision as a number, zero if the chain id has no revision
+func (p ChainIdParts) RevisionNumber() (uint64, error) {
+	if !p.HasRevision() {
+		return 0, nil
+	}
+	return strconv.ParseUint(p.Revision, 10, 64)
+}
+
+// Validate ensures the number parts do not have leading zeros and the revision fits 64 bits
+func (p ChainIdParts) Validate() error {
+	if len(p.EvmChainId) > 1 && p.EvmChainId[0] == '0' {
+		return fmt.Errorf("EVM chain id part %s of chain id must not have leading zeros", p.EvmChainId)
+	}
+	if len(p.Revision) > 1 && p.Revision[0] == '0' {
+		return fmt.Errorf("revision %s of chain id must not have leading zeros", p.Revision)
+	}
+	if _, err := p.RevisionNumber(); err != nil {
+		return fmt.Errorf("revision %s of chain id is out of range", p.Revision)
+	}
+	return nil
+}
+
+func (p ChainIdParts) String() string {
+	chainId := p.Name
+	if p.EvmChainId != "" {
+		chainId += "_" + p.EvmChainId
+	}
+	if p.Revision != "" {
+		chainId += "-" + p.Revision
+	}
+	return chainId
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_ParseChainId(t *testing.T) {
+	tests := []struct {
+		chainId      string
+		want         ChainIdParts
+		wantRevision uint64
+		wantErr      bool
+	}{
+		{
+			chainId: "ethereum",
+			want:    ChainIdParts{Name: "ethereum"},
+		},
+		{
+			chainId:      "cosmoshub-4",
+			want:         ChainIdParts{Name: "cosmoshub", Revision: "4"},
+			wantRevision: 4,
+		},
+		{
+			chainId:      "dymension_1100-1",
+			want:         ChainIdParts{Name: "dymension", EvmChainId: "1100", Revision: "1"},
+			wantRevision: 1,
+		},
+		{
+			chainId:      "froopyland_100-1",
+			want:         ChainIdParts{Name: "froopyland", EvmChainId: "100", Revision: "1"},
+			wantRevision: 1,
+		},
+		{
+			chainId:      "osmo-test-5",
+			want:         ChainIdParts{Name: "osmo-test", Revision: "5"},
+			wantRevision: 5,
+		},
+		{
+			chainId: "mocha-testnet",
+			want:    ChainIdParts{Name: "mocha-testnet"},
+		},
+		{
+			chainId: "foo-0",
+			want:    ChainIdParts{Name: "foo", Revision: "0"},
+		},
+		{
+			chainId: "theta-testnet-001",
+			want:    ChainIdParts{Name: "theta-testnet", Revision: "001"},
+			wantErr: true,
+		},
+		{
+			chainId: "foo_0100-1",
+			want:    ChainIdParts{Name: "foo", EvmChainId: "0100", Revision: "1"},
+			wantErr: true,
+		},
+		{
+			chainId: "foo_100-01",
+			want:    ChainIdParts{Name: "foo", EvmChainId: "100", Revision: "01"},
+			wantErr: true,
+		},
+		{
+			chainId: "foo-99999999999999999999",
+			want:    ChainIdParts{Name: "foo", Revision: "99999999999999999999"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chainId, func(t *testing.T) {
+			got := ParseChainId(tt.chainId)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.chainId, got.String())
+
+			err := got.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			revision, err := got.RevisionNumber()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRevision, revision)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import "strings"
+
+type ChainType string
+
+const (
+	ChainTypeRollApp ChainType = "RollApp"
+	ChainTypeRegular ChainType = "Regular"
+	ChainTypeEVM     ChainType = "EVM"
+	ChainTypeHub     ChainType = "Hub"
+	ChainTypeSolana  ChainType = "Solana"
+)
+
+// BuiltInChainTypes returns the chain types which are recognized without any configuration
+func BuiltInChainTypes() []ChainType {
+	return []ChainType{ChainTypeRollApp, ChainTypeRegular, ChainTypeEVM, ChainTypeHub, ChainTypeSolana}
+}
+
+func (ct ChainType) String() string {
+	return string(ct)
+}
+
+// ChainTypeAllowList is a list of allowed chain types, Source describes where the list comes from.
+type ChainTypeAllowList struct {
+	Source     string
+	ChainTypes []ChainType
+}
+
+// Match returns the chain type of the list which is the same as the given chain type,
+// letter case is ignored when caseInsensitive is true.
+func (l ChainTypeAllowList) Match(chainType string, caseInsensitive bool) (ChainType, bool) {
+	if chainType == "" {
+		return "", false
+	}
+	for _, allowed := range l.ChainTypes {
+		if string(allowed) == chainType {
+			return allowed, true
+		}
+	}
+	if caseInsensitive {
+		for _, allowed := range l.ChainTypes {
+			if strings.EqualFold(string(allowed), chainType) {
+				return allowed, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ResolveChainType consults the allow lists in order and returns the first matching chain type,
+// along with the allow list it was found in.
+func ResolveChainType(chainType string, allowLists []ChainTypeAllowList, caseInsensitive bool) (resolved ChainType, allowList ChainTypeAllowList, found bool) {
+	for _, list := range allowLists {
+		if resolved, found = list.Match(chainType, caseInsensitive); found {
+			return resolved, list, true
+		}
+	}
+	return "", ChainTypeAllowList{}, false
+}
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChainTypeRequirements declares which fields a chain of a type must, may or must not have.
+// Fields are the JSON field names of the chain definition, fields of currencies are prefixed by "currencies.",
+// e.g. "currencies.bridgeDenom". A field is considered present when it is not the zero value.
+// Fields which are not listed are optional.
+type ChainTypeRequirements struct {
+	Required  []string `json:"required,omitempty"`
+	Optional  []string `json:"optional,omitempty"`
+	Forbidden []string `json:"forbidden,omitempty"`
+}
+
+// FieldViolation is a field which does not satisfy the requirements of the chain type
+type FieldViolation struct {
+	Field string
+	// Currency is the display denom of the currency which violates, for currency fields only
+	Currency string
+	// Missing is true if the field is required but not present, otherwise the field is forbidden but present
+	Missing bool
+}
+
+const currencyFieldPrefix = "currencies."
+
+var chainDefinitionFieldPresence = map[string]func(cd ChainDefinition) bool{
+	"chainId":       func(cd ChainDefinition) bool { return cd.ChainId != "" },
+	"chainName":     func(cd ChainDefinition) bool { return cd.ChainName != "" },
+	"rpc":           func(cd ChainDefinition) bool { return isPresentUrls(cd.GetRpcUrls()) },
+	"rest":          func(cd ChainDefinition) bool { return isPresentUrls(cd.GetRestUrls()) },
+	"beRpc":         func(cd ChainDefinition) bool { return isPresentUrls(cd.GetBeRpcUrls()) },
+	"bech32Prefix":  func(cd ChainDefinition) bool { return cd.Bech32Prefix != "" },
+	"website":       func(cd ChainDefinition) bool { return cd.WebSite != "" },
+	"da":            func(cd ChainDefinition) bool { return cd.DA != "" },
+	"evm":           func(cd ChainDefinition) bool { return cd.EVM != nil },
+	"currencies":    func(cd ChainDefinition) bool { return len(cd.Currencies) > 0 },
+	"coinType":      func(cd ChainDefinition) bool { return cd.CoinType != 0 },
+	"gasAdjustment": func(cd ChainDefinition) bool { return cd.GasAdjustment != 0 },
+	"faucetUrl":     func(cd ChainDefinition) bool { return cd.FaucetUrl != "" },
+	"ibc":           func(cd ChainDefinition) bool { return cd.IBC != nil },
+	"gasPriceSteps": func(cd ChainDefinition) bool { return cd.GasPriceSteps != nil },
+	"logo":          func(cd ChainDefinition) bool { return cd.Logo != "" },
+	"type":          func(cd ChainDefinition) bool { return cd.Type != "" },
+	"active":        func(cd ChainDefinition) bool { return cd.Active },
+	"analytics":     func(cd ChainDefinition) bool { return cd.Analytics },
+	"collectData":   func(cd ChainDefinition) bool { return cd.CollectData },
+	"goldberg":      func(cd ChainDefinition) bool { return cd.Goldberg },
+	"availAddress":  func(cd ChainDefinition) bool { return cd.AvailAddress != "" },
+}
+
+var currencyFieldPresence = map[string]func(c CurrencyChainDefinition) bool{
+	"displayDenom":      func(c CurrencyChainDefinition) bool { return c.DisplayDenom != "" },
+	"baseDenom":         func(c CurrencyChainDefinition) bool { return c.BaseDenom != "" },
+	"ibcRepresentation": func(c CurrencyChainDefinition) bool { return c.IbcRepresentation != "" },
+	"bridgeDenom":       func(c CurrencyChainDefinition) bool { return c.BridgeDenom != "" },
+	"decimals":          func(c CurrencyChainDefinition) bool { return c.Decimals != 0 },
+	"logo":              func(c CurrencyChainDefinition) bool { return c.Logo != "" },
+	"type":              func(c CurrencyChainDefinition) bool { return c.Type != "" },
+}
+
+// DefaultChainTypeRequirements returns the requirements of the built-in chain types
+func DefaultChainTypeRequirements() map[ChainType]ChainTypeRequirements {
+	return map[ChainType]ChainTypeRequirements{
+		ChainTypeRollApp: {
+			Required: []string{"currencies", "coinType", "bech32Prefix", "da"},
+			Optional: []string{"evm", "availAddress", "goldberg"},
+		},
+		ChainTypeRegular: {
+			Required:  []string{"currencies", "coinType"},
+			Optional:  []string{"bech32Prefix", "evm"},
+			Forbidden: []string{"da", "availAddress", "goldberg"},
+		},
+		ChainTypeHub: {
+			Required:  []string{"currencies", "coinType"},
+			Optional:  []string{"bech32Prefix", "evm"},
+			Forbidden: []string{"da", "availAddress", "goldberg"},
+		},
+		ChainTypeEVM: {
+			Required:  []string{"currencies", "currencies.bridgeDenom"},
+			Optional:  []string{"coinType", "bech32Prefix", "evm"},
+			Forbidden: []string{"da", "availAddress", "goldberg"},
+		},
+		ChainTypeSolana: {
+			Required:  []string{"currencies", "currencies.bridgeDenom"},
+			Optional:  []string{"coinType"},
+			Forbidden: []string{"da", "availAddress", "goldberg", "bech32Prefix", "evm"},
+		},
+	}
+}
+
+// DefaultRequirementsOfUnknownChainType returns the requirements applied to chain types which have no entry
+// in the requirements table, e.g. chain types allowed via --addition-chain-types-allowed.
+// They are the rules which apply to every non-RollApp chain: currencies and coin type are required,
+// DA and DA-specific fields are forbidden.
+func DefaultRequirementsOfUnknownChainType() ChainTypeRequirements {
+	return ChainTypeRequirements{
+		Required:  []string{"currencies", "coinType"},
+		Forbidden: []string{"da", "availAddress", "goldberg"},
+	}
+}
+
+// IsKnownField returns true if the field name is a known field of the chain definition or of its currencies
+func IsKnownField(field string) bool {
+	if strings.HasPrefix(field, currencyFieldPrefix) {
+		_, found := currencyFieldPresence[strings.TrimPrefix(field, currencyFieldPrefix)]
+		return found
+	}
+	_, found := chainDefinitionFieldPresence[field]
+	return found
+}
+
+// HasField returns true if the field of the chain definition is present, i.e. not the zero value.
+// Currency fields are not supported, false is returned for unknown fields.
+func (cd ChainDefinition) HasField(field string) bool {
+	isPresent := chainDefinitionFieldPresence[field]
+	return isPresent != nil && isPresent(cd)
+}
+
+// IsForbidden returns true if the field is listed as forbidden
+func (r ChainTypeRequirements) IsForbidden(field string) bool {
+	for _, forbiddenField := range r.Forbidden {
+		if forbiddenField == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate ensures all fields are known and each field is listed at most once
+func (r ChainTypeRequirements) Validate() error {
+	listedFields := make(map[string]bool)
+	for _, fields := range [][]string{r.Required, r.Optional, r.Forbidden} {
+		for _, field := range fields {
+			if !IsKnownField(field) {
+				return fmt.Errorf("not recognized field: %s", field)
+			}
+			if listedFields[field] {
+				return fmt.Errorf("field %s is listed more than once", field)
+			}
+			listedFields[field] = true
+		}
+	}
+	return nil
+}
+
+// Check returns the fields of the chain definition which do not satisfy the requirements.
+// A required currency field must be present in every currency, a forbidden currency field must not be present in any.
+func (r ChainTypeRequirements) Check(cd ChainDefinition) (violations []FieldViolation) {
+	check := func(field string, required bool) {
+		if strings.HasPrefix(field, currencyFieldPrefix) {
+			isPresent := currencyFieldPresence[strings.TrimPrefix(field, currencyFieldPrefix)]
+			if isPresent == nil {
+				return
+			}
+			for _, currency := range cd.Currencies {
+				if isPresent(currency) != required {
+					violations = append(violations, FieldViolation{Field: field, Currency: currency.DisplayDenom, Missing: required})
+				}
+			}
+			return
+		}
+
+		isPresent := chainDefinitionFieldPresence[field]
+		if isPresent == nil {
+			return
+		}
+		if isPresent(cd) != required {
+			violations = append(violations, FieldViolation{Field: field, Missing: required})
+		}
+	}
+
+	for _, field := range r.Required {
+		check(field, true)
+	}
+	for _, field := range r.Forbidden {
+		check(field, false)
+	}
+	return
+}
+
+func isPresentUrls(urls []string, err error) bool {
+	if err != nil {
+		// present but malformed, reported by the URL validation
+		return true
+	}
+	return len(urls) > 0 && !(len(urls) == 1 && urls[0] == "")
+}
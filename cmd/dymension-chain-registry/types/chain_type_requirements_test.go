@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestChainTypeRequirements_Check(t *testing.T) {
+	requirements := DefaultChainTypeRequirements()
+
+	t.Run("RollApp missing required fields", func(t *testing.T) {
+		cd := ChainDefinition{
+			Type:       "RollApp",
+			CoinType:   118,
+			Currencies: []CurrencyChainDefinition{{DisplayDenom: "FOO"}},
+		}
+		require.Equal(t, []FieldViolation{
+			{Field: "bech32Prefix", Missing: true},
+			{Field: "da", Missing: true},
+		}, requirements["RollApp"].Check(cd))
+	})
+
+	t.Run("Solana forbidden fields and missing currency fields", func(t *testing.T) {
+		cd := ChainDefinition{
+			Type:         "Solana",
+			Bech32Prefix: "sol",
+			Currencies: []CurrencyChainDefinition{
+				{DisplayDenom: "SOL", BridgeDenom: "So11111111111111111111111111111111111111112"},
+				{DisplayDenom: "FOO"},
+			},
+		}
+		require.Equal(t, []FieldViolation{
+			{Field: "currencies.bridgeDenom", Currency: "FOO", Missing: true},
+			{Field: "bech32Prefix"},
+		}, requirements["Solana"].Check(cd))
+	})
+
+	t.Run("satisfied", func(t *testing.T) {
+		cd := ChainDefinition{
+			Type:       "Regular",
+			CoinType:   118,
+			Currencies: []CurrencyChainDefinition{{DisplayDenom: "ATOM"}},
+		}
+		require.Empty(t, requirements["Regular"].Check(cd))
+	})
+}
+
+func TestChainTypeRequirements_Validate(t *testing.T) {
+	for chainType, requirements := range DefaultChainTypeRequirements() {
+		require.NoError(t, requirements.Validate(), chainType)
+	}
+
+	require.Error(t, ChainTypeRequirements{Required: []string{"unknown"}}.Validate())
+	require.Error(t, ChainTypeRequirements{Forbidden: []string{"currencies.unknown"}}.Validate())
+	require.Error(t, ChainTypeRequirements{Required: []string{"da"}, Forbidden: []string{"da"}}.Validate())
+	require.NoError(t, ChainTypeRequirements{Required: []string{"currencies.logo"}, Forbidden: []string{"faucetUrl"}}.Validate())
+}
+
+func TestDefaultRequirementsOfUnknownChainType(t *testing.T) {
+	requirements := DefaultRequirementsOfUnknownChainType()
+	require.NoError(t, requirements.Validate())
+
+	require.Equal(t, []FieldViolation{
+		{Field: "currencies", Missing: true},
+		{Field: "coinType", Missing: true},
+		{Field: "availAddress"},
+	}, requirements.Check(ChainDefinition{Type: "Custom", AvailAddress: "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"}))
+}
+
+func TestChainTypeRequirements_IsForbidden(t *testing.T) {
+	requirements := ChainTypeRequirements{
+		Required:  []string{"currencies"},
+		Forbidden: []string{"da"},
+	}
+	require.True(t, requirements.IsForbidden("da"))
+	require.False(t, requirements.IsForbidden("currencies"))
+	require.False(t, requirements.IsForbidden("evm"))
+}
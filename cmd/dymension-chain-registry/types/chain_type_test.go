@@ -0,0 +1,113 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_ResolveChainType(t *testing.T) {
+	allowLists := []ChainTypeAllowList{
+		{Source: "built-in", ChainTypes: BuiltInChainTypes()},
+		{Source: "rule config", ChainTypes: []ChainType{"Cosmos"}},
+		{Source: "flag", ChainTypes: []ChainType{"Bitcoin"}},
+	}
+
+	tests := []struct {
+		name            string
+		chainType       string
+		allowLists      []ChainTypeAllowList
+		caseInsensitive bool
+		wantFound       bool
+		wantResolved    ChainType
+		wantSource      string
+	}{
+		{
+			name:         "built-in",
+			chainType:    "RollApp",
+			allowLists:   allowLists,
+			wantFound:    true,
+			wantResolved: ChainTypeRollApp,
+			wantSource:   "built-in",
+		},
+		{
+			name:         "from rule config",
+			chainType:    "Cosmos",
+			allowLists:   allowLists,
+			wantFound:    true,
+			wantResolved: "Cosmos",
+			wantSource:   "rule config",
+		},
+		{
+			name:         "from flag",
+			chainType:    "Bitcoin",
+			allowLists:   allowLists,
+			wantFound:    true,
+			wantResolved: "Bitcoin",
+			wantSource:   "flag",
+		},
+		{
+			name:       "additional chain type does not allow any other chain type",
+			chainType:  "Unknown",
+			allowLists: allowLists,
+			wantFound:  false,
+		},
+		{
+			name:       "empty chain type",
+			chainType:  "",
+			allowLists: allowLists,
+			wantFound:  false,
+		},
+		{
+			name:       "letter case mismatch when case-sensitive",
+			chainType:  "rollapp",
+			allowLists: allowLists,
+			wantFound:  false,
+		},
+		{
+			name:            "letter case mismatch when case-insensitive",
+			chainType:       "rollapp",
+			allowLists:      allowLists,
+			caseInsensitive: true,
+			wantFound:       true,
+			wantResolved:    ChainTypeRollApp,
+			wantSource:      "built-in",
+		},
+		{
+			name:            "case-insensitive from flag",
+			chainType:       "BITCOIN",
+			allowLists:      allowLists,
+			caseInsensitive: true,
+			wantFound:       true,
+			wantResolved:    "Bitcoin",
+			wantSource:      "flag",
+		},
+		{
+			name:       "prefix does not match",
+			chainType:  "Roll",
+			allowLists: allowLists,
+			wantFound:  false,
+		},
+		{
+			name:       "no allow list",
+			chainType:  "RollApp",
+			allowLists: nil,
+			wantFound:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, allowList, found := ResolveChainType(tt.chainType, tt.allowLists, tt.caseInsensitive)
+			require.Equal(t, tt.wantFound, found)
+			require.Equal(t, tt.wantResolved, resolved)
+			require.Equal(t, tt.wantSource, allowList.Source)
+		})
+	}
+}
+
+func TestChainTypeAllowList_Match_PrefersExactMatch(t *testing.T) {
+	allowList := ChainTypeAllowList{ChainTypes: []ChainType{"evm", "EVM"}}
+
+	resolved, found := allowList.Match("EVM", true)
+	require.True(t, found)
+	require.Equal(t, ChainTypeEVM, resolved)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+)
+
+type DenomKind string
+
+const (
+	// DenomKindIbc is the IBC voucher denom, format ibc/<HASH>
+	DenomKindIbc DenomKind = "ibc"
+	// DenomKindFactory is the token factory denom, format factory/<creator-bech32-address>/<sub-denom>
+	DenomKindFactory DenomKind = "factory"
+	// DenomKindErc20 is the denom of ERC-20 token converted into Cosmos coin, format erc20/0x<contract-address>
+	DenomKindErc20 DenomKind = "erc20"
+	// DenomKindGammPool is the Osmosis liquidity pool share denom, format gamm/pool/<pool-id>
+	DenomKindGammPool DenomKind = "gamm-pool"
+	// DenomKindNative is the denom without any slash, e.g. "adym" or "uatom"
+	DenomKindNative DenomKind = "native"
+	// DenomKindOther is any other denom containing slash
+	DenomKindOther DenomKind = "other"
+)
+
+// Denom is the structured form of a base denom or bridge denom
+type Denom struct {
+	Raw  string
+	Kind DenomKind
+
+	// IbcHash is the hash part of IBC denom
+	IbcHash string
+
+	// FactoryCreator and FactorySubDenom are parts of token factory denom
+	FactoryCreator  string
+	FactorySubDenom string
+
+	// ContractAddress is the contract address part of ERC-20 denom
+	ContractAddress string
+
+	// PoolId is the pool id part of gamm pool denom
+	PoolId string
+
+	// UnitPrefix is the first character of native denom when it is a well-known unit prefix:
+	// "a" (atto, 10^-18) or "u" (micro, 10^-6), otherwise empty.
+	UnitPrefix string
+}
+
+// ParseDenom parses the well-known structured forms of denom.
+// It does not validate each part, only splits the denom based on its prefix.
+func ParseDenom(denom string) Denom {
+	parsed := Denom{
+		Raw: denom,
+	}
+
+	switch {
+	case strings.HasPrefix(denom, "ibc/"):
+		parsed.Kind = DenomKindIbc
+		parsed.IbcHash = strings.TrimPrefix(denom, "ibc/")
+	case strings.HasPrefix(denom, "factory/"):
+		parsed.Kind = DenomKindFactory
+		parts := strings.SplitN(strings.TrimPrefix(denom, "factory/"), "/", 2)
+		parsed.FactoryCreator = parts[0]
+		if len(parts) > 1 {
+			parsed.FactorySubDenom = parts[1]
+		}
+	case strings.HasPrefix(denom, "erc20/"):
+		parsed.Kind = DenomKindErc20
+		parsed.ContractAddress = strings.TrimPrefix(denom, "erc20/")
+	case strings.HasPrefix(denom, "gamm/pool/"):
+		parsed.Kind = DenomKindGammPool
+		parsed.PoolId = strings.TrimPrefix(denom, "gamm/pool/")
+	case strings.Contains(denom, "/"):
+		parsed.Kind = DenomKindOther
+	default:
+		parsed.Kind = DenomKindNative
+		if regexp.MustCompile(`^[au][a-z][a-z\d]*$`).MatchString(denom) {
+			parsed.UnitPrefix = denom[:1]
+		}
+	}
+
+	return parsed
+}
+
+// ExpectedDecimals returns the decimals implied by the unit prefix of native denom
+func (d Denom) ExpectedDecimals() (decimals int64, found bool) {
+	switch d.UnitPrefix {
+	case "a":
+		return 18, true
+	case "u":
+		return 6, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_ParseDenom(t *testing.T) {
+	tests := []struct {
+		denom string
+		want  Denom
+	}{
+		{
+			denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			want:  Denom{Kind: DenomKindIbc, IbcHash: "27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+		},
+		{
+			denom: "factory/dym1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu/sub/denom",
+			want:  Denom{Kind: DenomKindFactory, FactoryCreator: "dym1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", FactorySubDenom: "sub/denom"},
+		},
+		{
+			denom: "factory/creator",
+			want:  Denom{Kind: DenomKindFactory, FactoryCreator: "creator"},
+		},
+		{
+			denom: "erc20/0xdAC17F958D2ee523a2206206994597C13D831ec7",
+			want:  Denom{Kind: DenomKindErc20, ContractAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec7"},
+		},
+		{
+			denom: "gamm/pool/1",
+			want:  Denom{Kind: DenomKindGammPool, PoolId: "1"},
+		},
+		{
+			denom: "adym",
+			want:  Denom{Kind: DenomKindNative, UnitPrefix: "a"},
+		},
+		{
+			denom: "uatom",
+			want:  Denom{Kind: DenomKindNative, UnitPrefix: "u"},
+		},
+		{
+			denom: "Uatom",
+			want:  Denom{Kind: DenomKindNative},
+		},
+		{
+			denom: "transfer/channel-0/uatom",
+			want:  Denom{Kind: DenomKindOther},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.denom, func(t *testing.T) {
+			tt.want.Raw = tt.denom
+			require.Equal(t, tt.want, ParseDenom(tt.denom))
+		})
+	}
+}
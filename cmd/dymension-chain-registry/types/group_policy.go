@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GroupPolicy holds the rules which differ between groups, e.g. mainnet is stricter than devnets.
+type GroupPolicy struct {
+	// RequireHttps requires endpoint urls to use https (or wss)
+	RequireHttps bool `json:"requireHttps"`
+
+	// AllowPrivateHosts allows urls pointing to localhost, .local hosts, private or link-local IPs
+	AllowPrivateHosts bool `json:"allowPrivateHosts"`
+
+	// ForbiddenDA is the list of DA layers which RollApps of the group must not use
+	ForbiddenDA []string `json:"forbiddenDa,omitempty"`
+
+	// ForbiddenFields is the list of JSON field names of the chain definition which chains of the group must not have
+	ForbiddenFields []string `json:"forbiddenFields,omitempty"`
+
+	// RecommendedFields is the list of JSON field names of the chain definition which chains of the group should have,
+	// missing fields are reported as warnings.
+	RecommendedFields []string `json:"recommendedFields,omitempty"`
+
+	// CollectData is the expected value of the collectData field, different values are reported as warnings.
+	// Nil means no expectation.
+	CollectData *bool `json:"collectData"`
+}
+
+// DefaultGroupPolicies returns the policies of the built-in groups
+func DefaultGroupPolicies() map[ValidateTarget]GroupPolicy {
+	return map[ValidateTarget]GroupPolicy{
+		ValidateMainnet: {
+			RequireHttps: true,
+			// data of RollApps using local DA is not posted to any DA network
+			ForbiddenDA:     []string{"local"},
+			ForbiddenFields: []string{"faucetUrl", "goldberg"},
+			// data of mainnet chains is expected to be collected
+			CollectData: boolPtr(true),
+		},
+		ValidateTestnet: {
+			RecommendedFields: []string{"faucetUrl"},
+		},
+		ValidateDevnet: {},
+		ValidateInternalDevnet: {
+			AllowPrivateHosts: true,
+			// endpoints of internal devnets are commonly not reachable by the data collector
+			CollectData: boolPtr(false),
+		},
+	}
+}
+
+// Validate ensures all fields are known fields of the chain definition
+func (gp GroupPolicy) Validate() error {
+	for _, da := range gp.ForbiddenDA {
+		if strings.TrimSpace(da) == "" {
+			return fmt.Errorf("forbidden DA must not be empty")
+		}
+	}
+	for _, fields := range [][]string{gp.ForbiddenFields, gp.RecommendedFields} {
+		for _, field := range fields {
+			if _, found := chainDefinitionFieldPresence[field]; !found {
+				return fmt.Errorf("not recognized field: %s", field)
+			}
+		}
+	}
+	for _, field := range gp.ForbiddenFields {
+		for _, recommendedField := range gp.RecommendedFields {
+			if field == recommendedField {
+				return fmt.Errorf("field %s can not be both forbidden and recommended", field)
+			}
+		}
+	}
+	return nil
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGroupPolicy_Validate(t *testing.T) {
+	for target, policy := range DefaultGroupPolicies() {
+		require.NoError(t, policy.Validate(), target)
+	}
+
+	require.Error(t, GroupPolicy{ForbiddenFields: []string{"unknown"}}.Validate())
+	require.Error(t, GroupPolicy{RecommendedFields: []string{"currencies.bridgeDenom"}}.Validate())
+	require.Error(t, GroupPolicy{ForbiddenDA: []string{" "}}.Validate())
+	require.Error(t, GroupPolicy{ForbiddenFields: []string{"faucetUrl"}, RecommendedFields: []string{"faucetUrl"}}.Validate())
+	require.NoError(t, GroupPolicy{ForbiddenDA: []string{"local"}, RecommendedFields: []string{"website"}}.Validate())
+}
+
+func TestRuleConfig_GroupPolicy(t *testing.T) {
+	ruleConfig := DefaultRuleConfig()
+
+	require.True(t, ruleConfig.GroupPolicy(ValidateMainnet).RequireHttps)
+	require.Contains(t, ruleConfig.GroupPolicy(ValidateMainnet).ForbiddenFields, "faucetUrl")
+	require.Contains(t, ruleConfig.GroupPolicy(ValidateTestnet).RecommendedFields, "faucetUrl")
+	require.True(t, ruleConfig.GroupPolicy(ValidateInternalDevnet).AllowPrivateHosts)
+	require.NotNil(t, ruleConfig.GroupPolicy(ValidateMainnet).CollectData)
+	require.True(t, *ruleConfig.GroupPolicy(ValidateMainnet).CollectData)
+	require.Nil(t, ruleConfig.GroupPolicy(ValidateTestnet).CollectData)
+	require.NotNil(t, ruleConfig.GroupPolicy(ValidateInternalDevnet).CollectData)
+	require.False(t, *ruleConfig.GroupPolicy(ValidateInternalDevnet).CollectData)
+	require.Equal(t, GroupPolicy{}, ruleConfig.GroupPolicy("playground"))
+}
+
+func TestLoadRuleConfig_GroupPolicyOverride(t *testing.T) {
+	loadRuleConfig := func(t *testing.T, content string) RuleConfig {
+		filePath := filepath.Join(t.TempDir(), "rule-config.json")
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o644))
+		ruleConfig, err := LoadRuleConfig(filePath)
+		require.NoError(t, err)
+		return ruleConfig
+	}
+
+	t.Run("fields not present keep the built-in value", func(t *testing.T) {
+		ruleConfig := loadRuleConfig(t, `{"groups": {"mainnet": {"forbiddenFields": ["faucetUrl"]}}}`)
+
+		mainnet := ruleConfig.GroupPolicy(ValidateMainnet)
+		require.Equal(t, []string{"faucetUrl"}, mainnet.ForbiddenFields)
+		require.True(t, mainnet.RequireHttps)
+		require.Equal(t, []string{"local"}, mainnet.ForbiddenDA)
+		require.NotNil(t, mainnet.CollectData)
+		require.True(t, *mainnet.CollectData)
+
+		// other groups are not affected
+		require.Equal(t, DefaultGroupPolicies()[ValidateInternalDevnet], ruleConfig.GroupPolicy(ValidateInternalDevnet))
+	})
+
+	t.Run("fields present override the built-in value", func(t *testing.T) {
+		ruleConfig := loadRuleConfig(t, `{"groups": {"mainnet": {"requireHttps": false, "forbiddenDa": [], "collectData": null}}}`)
+
+		mainnet := ruleConfig.GroupPolicy(ValidateMainnet)
+		require.False(t, mainnet.RequireHttps)
+		require.Empty(t, mainnet.ForbiddenDA)
+		require.Nil(t, mainnet.CollectData)
+		require.Equal(t, []string{"faucetUrl", "goldberg"}, mainnet.ForbiddenFields)
+	})
+
+	t.Run("group without built-in policy", func(t *testing.T) {
+		ruleConfig := loadRuleConfig(t, `{"groups": {"playground": {"allowPrivateHosts": true}}}`)
+		require.Equal(t, GroupPolicy{AllowPrivateHosts: true}, ruleConfig.GroupPolicy("playground"))
+		require.Equal(t, DefaultGroupPolicies()[ValidateMainnet], ruleConfig.GroupPolicy(ValidateMainnet))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "rule-config.json")
+		require.NoError(t, os.WriteFile(filePath, []byte(`{"groups": {"mainnet": {"requireHttp": true}}}`), 0o644))
+		_, err := LoadRuleConfig(filePath)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// RegistryGroupsFileName is the name of the file at the registry root which declares the groups of the registry
+const RegistryGroupsFileName = "registry-groups.json"
+
+// RegistryGroupMarkerFileName is the name of the file which marks a top-level directory of the registry as a group,
+// used to discover groups when the registry does not declare them by RegistryGroupsFileName.
+const RegistryGroupMarkerFileName = ".registry-group"
+
+// RegistryGroups is the content of the groups declaration file at the registry root.
+// Each group is a top-level directory of the registry holding the chain directories.
+type RegistryGroups struct {
+	Groups []ValidateTarget `json:"groups"`
+}
+
+// LoadRegistryGroups reads the groups declared by the registry.
+// When the registry does not declare its groups, declared is false and the groups are the built-in groups
+// followed by the top-level directories marked by RegistryGroupMarkerFileName, in alphabetical order.
+func LoadRegistryGroups(repoDir string) (groups []ValidateTarget, declared bool, err error) {
+	bz, err := os.ReadFile(filepath.Join(repoDir, RegistryGroupsFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			groups, err = discoverRegistryGroups(repoDir)
+			return groups, false, err
+		}
+		return nil, false, err
+	}
+
+	var registryGroups RegistryGroups
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&registryGroups); err != nil {
+		return nil, false, fmt.Errorf("failed to decode %s: %w", RegistryGroupsFileName, err)
+	}
+
+	if err := registryGroups.Validate(); err != nil {
+		return nil, false, fmt.Errorf("bad %s: %w", RegistryGroupsFileName, err)
+	}
+
+	return registryGroups.Groups, true, nil
+}
+
+// discoverRegistryGroups returns the built-in groups followed by the top-level directories of the registry
+// which contain RegistryGroupMarkerFileName.
+func discoverRegistryGroups(repoDir string) ([]ValidateTarget, error) {
+	groups := BuiltInValidateTargets()
+
+	entries, err := os.ReadDir(repoDir)
+	if err != nil {
+		return nil, err
+	}
+
+	isBuiltIn := make(map[ValidateTarget]bool)
+	for _, group := range groups {
+		isBuiltIn[group] = true
+	}
+
+	// entries are sorted by file name
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(repoDir, entry.Name(), RegistryGroupMarkerFileName)); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		group := ValidateTarget(entry.Name())
+		if err := group.Validate(); err != nil {
+			return nil, fmt.Errorf("bad group directory marked by %s: %w", RegistryGroupMarkerFileName, err)
+		}
+		if !isBuiltIn[group] {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups, nil
+}
+
+// Validate ensures there is at least one group, and groups are unique valid names
+func (rg RegistryGroups) Validate() error {
+	if len(rg.Groups) == 0 {
+		return fmt.Errorf("at least one group must be declared")
+	}
+	uniqueGroupTracker := make(map[ValidateTarget]bool)
+	for _, group := range rg.Groups {
+		if err := group.Validate(); err != nil {
+			return err
+		}
+		if uniqueGroupTracker[group] {
+			return fmt.Errorf("duplicated group: %s", group)
+		}
+		uniqueGroupTracker[group] = true
+	}
+	return nil
+}
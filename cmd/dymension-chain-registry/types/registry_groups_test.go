@@ -0,0 +1,87 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_LoadRegistryGroups(t *testing.T) {
+	writeGroups := func(t *testing.T, content string) string {
+		repoDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(repoDir, RegistryGroupsFileName), []byte(content), 0o644))
+		return repoDir
+	}
+
+	t.Run("not declared", func(t *testing.T) {
+		groups, declared, err := LoadRegistryGroups(t.TempDir())
+		require.NoError(t, err)
+		require.False(t, declared)
+		require.Equal(t, BuiltInValidateTargets(), groups)
+	})
+
+	t.Run("discovered by marker", func(t *testing.T) {
+		repoDir := t.TempDir()
+		for _, dir := range []string{"mainnet", "testnet", "staging", "playground", "assets"} {
+			require.NoError(t, os.Mkdir(filepath.Join(repoDir, dir), 0o755))
+		}
+		for _, dir := range []string{"mainnet", "staging", "playground"} {
+			require.NoError(t, os.WriteFile(filepath.Join(repoDir, dir, RegistryGroupMarkerFileName), nil, 0o644))
+		}
+		// marker file which is not within a directory is ignored
+		require.NoError(t, os.WriteFile(filepath.Join(repoDir, RegistryGroupMarkerFileName), nil, 0o644))
+
+		groups, declared, err := LoadRegistryGroups(repoDir)
+		require.NoError(t, err)
+		require.False(t, declared)
+		require.Equal(t, append(BuiltInValidateTargets(), "playground", "staging"), groups)
+	})
+
+	t.Run("discovered by marker with bad directory name", func(t *testing.T) {
+		repoDir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(repoDir, "Staging"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(repoDir, "Staging", RegistryGroupMarkerFileName), nil, 0o644))
+
+		_, _, err := LoadRegistryGroups(repoDir)
+		require.Error(t, err)
+	})
+
+	t.Run("declaration file takes precedence over markers", func(t *testing.T) {
+		repoDir := writeGroups(t, `{"groups": ["mainnet"]}`)
+		require.NoError(t, os.Mkdir(filepath.Join(repoDir, "staging"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(repoDir, "staging", RegistryGroupMarkerFileName), nil, 0o644))
+
+		groups, declared, err := LoadRegistryGroups(repoDir)
+		require.NoError(t, err)
+		require.True(t, declared)
+		require.Equal(t, []ValidateTarget{ValidateMainnet}, groups)
+	})
+
+	t.Run("declared", func(t *testing.T) {
+		groups, declared, err := LoadRegistryGroups(writeGroups(t, `{"groups": ["mainnet", "playground", "staging-eu"]}`))
+		require.NoError(t, err)
+		require.True(t, declared)
+		require.Equal(t, []ValidateTarget{ValidateMainnet, "playground", "staging-eu"}, groups)
+	})
+
+	for name, content := range map[string]string{
+		"empty list":       `{"groups": []}`,
+		"duplicated group": `{"groups": ["mainnet", "mainnet"]}`,
+		"bad group name":   `{"groups": ["Staging"]}`,
+		"path traversal":   `{"groups": ["../mainnet"]}`,
+		"unknown field":    `{"groups": ["mainnet"], "group": "testnet"}`,
+		"malformed":        `{"groups": "mainnet"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := LoadRegistryGroups(writeGroups(t, content))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestValidateTarget_String(t *testing.T) {
+	require.Equal(t, "Mainnet", ValidateMainnet.String())
+	require.Equal(t, "Internal Devnet", ValidateInternalDevnet.String())
+	require.Equal(t, "Playground", ValidateTarget("playground").String())
+}
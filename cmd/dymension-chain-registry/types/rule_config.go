@@ -0,0 +1,249 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RuleConfig holds the configurable validation rules.
+// Default values are provided by DefaultRuleConfig and can be overridden by a JSON file via LoadRuleConfig.
+type RuleConfig struct {
+	DirectoryName   DirectoryNameRuleConfig   `json:"directoryName"`
+	StrayFiles      StrayFilesRuleConfig      `json:"strayFiles"`
+	Logo            LogoRuleConfig            `json:"logo"`
+	TickerCollision TickerCollisionRuleConfig `json:"tickerCollision"`
+
+	// ChainTypes holds the field requirements per chain type.
+	// Entries from the rule config file replace the built-in entry of the same chain type, or add a new chain type.
+	ChainTypes map[ChainType]ChainTypeRequirements `json:"chainTypes"`
+
+	ChainTypeMatching ChainTypeMatchingRuleConfig `json:"chainTypeMatching"`
+
+	// Groups holds the policy per group.
+	// Fields of entries from the rule config file override the same fields of the built-in entry of the same group,
+	// fields which are not present in the rule config file keep the built-in value.
+	Groups map[ValidateTarget]GroupPolicy `json:"groups,omitempty"`
+}
+
+// DirectoryNameRuleConfig controls how the name of a chain directory relates to the chain definition inside it.
+type DirectoryNameRuleConfig struct {
+	// MatchAnyOf is the list of rules, directory name must satisfy at least one of them.
+	// Empty list disables the check.
+	MatchAnyOf []DirectoryNameRule `json:"matchAnyOf"`
+
+	// AllowCaseOnlyCollision disables the check for directories which names are only different in letter case.
+	AllowCaseOnlyCollision bool `json:"allowCaseOnlyCollision,omitempty"`
+}
+
+// StrayFilesRuleConfig controls detection of files in chain directories which are not used by the chain definition.
+type StrayFilesRuleConfig struct {
+	// Disabled turns off the stray files detection
+	Disabled bool `json:"disabled,omitempty"`
+
+	// MaxFileSizeBytes is the maximum size of any file within a chain directory, zero to disable the check.
+	MaxFileSizeBytes int64 `json:"maxFileSizeBytes"`
+
+	// AllowedFiles is the list of glob patterns of file names which are allowed to exist in chain directories
+	// without being referenced, e.g. "README.md".
+	AllowedFiles []string `json:"allowedFiles,omitempty"`
+}
+
+// LogoRuleConfig controls validation of logo files of chains and currencies.
+type LogoRuleConfig struct {
+	// SharedAssetsDir is the directory, relative to the repository root, which logo files can be placed in,
+	// beside the chain directory. Empty means logo files must be placed within the chain directory.
+	SharedAssetsDir string `json:"sharedAssetsDir,omitempty"`
+
+	// MaxBytes is the maximum size of logo files, zero to disable the check.
+	MaxBytes int64 `json:"maxBytes"`
+
+	// MinWidth, MinHeight, MaxWidth and MaxHeight are dimension limits in pixels of PNG and JPEG logo files,
+	// zero to disable the corresponding check.
+	MinWidth  int `json:"minWidth"`
+	MinHeight int `json:"minHeight"`
+	MaxWidth  int `json:"maxWidth"`
+	MaxHeight int `json:"maxHeight"`
+
+	// RequireSquare requires PNG and JPEG logo files to have the same width and height.
+	RequireSquare bool `json:"requireSquare"`
+
+	// DisableDuplicateDetection turns off detection of identical logo images used by unrelated chains or currencies,
+	// and of currencies which logo differs from the same asset's logo on its origin chain.
+	DisableDuplicateDetection bool `json:"disableDuplicateDetection,omitempty"`
+
+	// DisableNearDuplicateDetection restricts the duplicate detection to byte-identical logo images only.
+	DisableNearDuplicateDetection bool `json:"disableNearDuplicateDetection,omitempty"`
+
+	// NearDuplicateMaxDistance is the maximum number of different bits between perceptual hashes (64 bits)
+	// of two PNG/JPEG logo images to consider them near-identical.
+	NearDuplicateMaxDistance int `json:"nearDuplicateMaxDistance"`
+}
+
+// TickerCollisionRuleConfig controls detection of the same display denom being used by unrelated assets.
+type TickerCollisionRuleConfig struct {
+	// Disabled turns off the ticker collision detection
+	Disabled bool `json:"disabled,omitempty"`
+
+	// AllowedTickers is the list of display denoms, case-insensitive, which are allowed to be used by unrelated assets.
+	AllowedTickers []string `json:"allowedTickers,omitempty"`
+}
+
+// ChainTypeMatchingRuleConfig controls how the chain type of chain definitions is matched against the allowed chain types.
+type ChainTypeMatchingRuleConfig struct {
+	// CaseInsensitive ignores letter case when matching, e.g. "rollapp" is accepted as "RollApp".
+	// By default, chain type must exactly match one of the allowed chain types.
+	CaseInsensitive bool `json:"caseInsensitive,omitempty"`
+}
+
+type DirectoryNameRule string
+
+const (
+	// DirectoryNameRuleChainId requires directory name to be the same as the chain id
+	DirectoryNameRuleChainId DirectoryNameRule = "chain-id"
+	// DirectoryNameRuleChainIdPrefix requires directory name to be the same as the chain id without EVM chain id and revision
+	DirectoryNameRuleChainIdPrefix DirectoryNameRule = "chain-id-prefix"
+	// DirectoryNameRuleChainNameSlug requires directory name to be the same as the slug of the chain name
+	DirectoryNameRuleChainNameSlug DirectoryNameRule = "chain-name-slug"
+)
+
+// DefaultRuleConfig returns the rule set which is used when no rule config file provided.
+func DefaultRuleConfig() RuleConfig {
+	return RuleConfig{
+		DirectoryName: DirectoryNameRuleConfig{
+			MatchAnyOf: []DirectoryNameRule{
+				DirectoryNameRuleChainId,
+				DirectoryNameRuleChainIdPrefix,
+				DirectoryNameRuleChainNameSlug,
+			},
+		},
+		StrayFiles: StrayFilesRuleConfig{
+			MaxFileSizeBytes: 1024 * 1024, // 1 MiB
+		},
+		Logo: LogoRuleConfig{
+			MaxBytes:      512 * 1024, // 512 KiB
+			MinWidth:      64,
+			MinHeight:     64,
+			MaxWidth:      2048,
+			MaxHeight:     2048,
+			RequireSquare: true,
+
+			NearDuplicateMaxDistance: 4,
+		},
+		ChainTypes: DefaultChainTypeRequirements(),
+		Groups:     DefaultGroupPolicies(),
+	}
+}
+
+// GroupPolicy returns the policy of the group, groups without policy have no group-specific rule.
+func (rc RuleConfig) GroupPolicy(target ValidateTarget) GroupPolicy {
+	return rc.Groups[target]
+}
+
+// LoadRuleConfig reads the rule config file and applies it on top of the default rule set.
+func LoadRuleConfig(filePath string) (RuleConfig, error) {
+	ruleConfig := DefaultRuleConfig()
+
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return ruleConfig, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&ruleConfig); err != nil {
+		return ruleConfig, fmt.Errorf("failed to decode rule config: %w", err)
+	}
+
+	// decoding replaces map entries entirely, group policies are decoded again on top of the built-in policies
+	// so only the fields present in the rule config file are overridden
+	var groupsConfig struct {
+		Groups map[ValidateTarget]json.RawMessage `json:"groups"`
+	}
+	if err := json.Unmarshal(bz, &groupsConfig); err != nil {
+		return ruleConfig, fmt.Errorf("failed to decode groups of rule config: %w", err)
+	}
+	builtInPolicies := DefaultGroupPolicies()
+	for target, rawPolicy := range groupsConfig.Groups {
+		policy := builtInPolicies[target]
+		if err := json.Unmarshal(rawPolicy, &policy); err != nil {
+			return ruleConfig, fmt.Errorf("failed to decode policy of group %s: %w", target, err)
+		}
+		ruleConfig.Groups[target] = policy
+	}
+
+	if err := ruleConfig.Validate(); err != nil {
+		return ruleConfig, err
+	}
+
+	return ruleConfig, nil
+}
+
+// Validate performs basic validation on the rule config.
+func (rc RuleConfig) Validate() error {
+	for _, rule := range rc.DirectoryName.MatchAnyOf {
+		switch rule {
+		case DirectoryNameRuleChainId, DirectoryNameRuleChainIdPrefix, DirectoryNameRuleChainNameSlug:
+		default:
+			return fmt.Errorf("not recognized directory name rule: %s", rule)
+		}
+	}
+	if rc.StrayFiles.MaxFileSizeBytes < 0 {
+		return fmt.Errorf("max file size of stray files rule must not be negative")
+	}
+	for _, pattern := range rc.StrayFiles.AllowedFiles {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("bad allowed file pattern %s: %w", pattern, err)
+		}
+	}
+	if rc.Logo.SharedAssetsDir != "" {
+		if filepath.IsAbs(rc.Logo.SharedAssetsDir) {
+			return fmt.Errorf("shared assets dir must be relative to the repository root")
+		}
+		if cleaned := filepath.Clean(rc.Logo.SharedAssetsDir); cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("shared assets dir must be within the repository")
+		}
+	}
+	if rc.Logo.MaxBytes < 0 {
+		return fmt.Errorf("max bytes of logo rule must not be negative")
+	}
+	if rc.Logo.MinWidth < 0 || rc.Logo.MinHeight < 0 || rc.Logo.MaxWidth < 0 || rc.Logo.MaxHeight < 0 {
+		return fmt.Errorf("dimension limits of logo rule must not be negative")
+	}
+	if rc.Logo.MaxWidth > 0 && rc.Logo.MinWidth > rc.Logo.MaxWidth {
+		return fmt.Errorf("min width of logo rule must not exceed max width")
+	}
+	if rc.Logo.MaxHeight > 0 && rc.Logo.MinHeight > rc.Logo.MaxHeight {
+		return fmt.Errorf("min height of logo rule must not exceed max height")
+	}
+	if rc.Logo.NearDuplicateMaxDistance < 0 || rc.Logo.NearDuplicateMaxDistance > 64 {
+		return fmt.Errorf("near duplicate max distance of logo rule must be in range 0-64")
+	}
+	uniqueChainTypeTracker := make(map[string]ChainType)
+	for chainType, requirements := range rc.ChainTypes {
+		if strings.TrimSpace(string(chainType)) == "" {
+			return fmt.Errorf("chain type must not be empty")
+		}
+		if rc.ChainTypeMatching.CaseInsensitive {
+			if existing, found := uniqueChainTypeTracker[strings.ToLower(string(chainType))]; found {
+				return fmt.Errorf("chain types %s and %s are ambiguous when matching case-insensitive", existing, chainType)
+			}
+			uniqueChainTypeTracker[strings.ToLower(string(chainType))] = chainType
+		}
+		if err := requirements.Validate(); err != nil {
+			return fmt.Errorf("bad requirements of chain type %s: %w", chainType, err)
+		}
+	}
+	for target, policy := range rc.Groups {
+		if err := target.Validate(); err != nil {
+			return fmt.Errorf("bad group of group policies: %w", err)
+		}
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("bad policy of group %s: %w", target, err)
+		}
+	}
+	return nil
+}
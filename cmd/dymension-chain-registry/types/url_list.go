@@ -0,0 +1,161 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// UrlEntry is an endpoint url along with optional metadata of the endpoint
+type UrlEntry struct {
+	Url      string `json:"url"`
+	Provider string `json:"provider,omitempty"`
+	Archive  bool   `json:"archive,omitempty"`
+
+	// object is true if the entry was declared as an object, so it is marshalled back as an object
+	object bool
+}
+
+// UrlList is a list of endpoint urls. In JSON, it can be a single string, a single object
+// like {"url": "...", "provider": "...", "archive": true}, or an array of strings and objects.
+// The original form is preserved when marshalling back to JSON.
+type UrlList struct {
+	Entries []UrlEntry
+
+	// array is true if the list was declared as an array
+	array bool
+	// raw is the original value which could not be decoded, it is marshalled back as is
+	raw json.RawMessage
+	// err is the error of decoding the original value
+	err error
+}
+
+// NewUrlList returns a list of the urls, it is marshalled as a single string if there is only one url.
+func NewUrlList(urls ...string) UrlList {
+	entries := make([]UrlEntry, len(urls))
+	for i, url := range urls {
+		entries[i] = UrlEntry{Url: url}
+	}
+	return UrlList{Entries: entries}
+}
+
+// Urls returns the urls of all entries
+func (l UrlList) Urls() []string {
+	if len(l.Entries) == 0 {
+		return nil
+	}
+	urls := make([]string, len(l.Entries))
+	for i, entry := range l.Entries {
+		urls[i] = entry.Url
+	}
+	return urls
+}
+
+// Err returns the error of decoding the JSON value, nil if the value is well-formed
+func (l UrlList) Err() error {
+	return l.err
+}
+
+// UnmarshalJSON decodes the url list. Malformed values do not fail the decoding of the chain definition,
+// the error is kept and returned by Err so it can be reported along with other validation issues.
+func (l *UrlList) UnmarshalJSON(bz []byte) error {
+	*l = UrlList{}
+
+	bz = bytes.TrimSpace(bz)
+	if bytes.Equal(bz, []byte("null")) {
+		return nil
+	}
+
+	var err error
+	if len(bz) > 0 && bz[0] == '[' {
+		var items []json.RawMessage
+		if err = json.Unmarshal(bz, &items); err == nil {
+			l.array = true
+			l.Entries = make([]UrlEntry, 0, len(items))
+			for _, item := range items {
+				var entry UrlEntry
+				if entry, err = decodeUrlEntry(item); err != nil {
+					break
+				}
+				l.Entries = append(l.Entries, entry)
+			}
+		}
+	} else {
+		var entry UrlEntry
+		if entry, err = decodeUrlEntry(bz); err == nil {
+			l.Entries = []UrlEntry{entry}
+		}
+	}
+
+	if err != nil {
+		*l = UrlList{
+			raw: append(json.RawMessage(nil), bz...),
+			err: err,
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the url list in the same form as it was declared
+func (l UrlList) MarshalJSON() ([]byte, error) {
+	if l.raw != nil {
+		return l.raw, nil
+	}
+
+	if !l.array {
+		switch len(l.Entries) {
+		case 0:
+			return []byte("null"), nil
+		case 1:
+			return l.Entries[0].MarshalJSON()
+		}
+	}
+
+	entries := l.Entries
+	if entries == nil {
+		entries = []UrlEntry{}
+	}
+	return json.Marshal(entries)
+}
+
+// MarshalJSON encodes the entry as a string, or as an object if it was declared as an object or has metadata
+func (e UrlEntry) MarshalJSON() ([]byte, error) {
+	if !e.object && e.Provider == "" && !e.Archive {
+		return json.Marshal(e.Url)
+	}
+	type urlEntryObject UrlEntry
+	return json.Marshal(urlEntryObject(e))
+}
+
+func decodeUrlEntry(bz json.RawMessage) (UrlEntry, error) {
+	bz = bytes.TrimSpace(bz)
+	if len(bz) == 0 {
+		return UrlEntry{}, fmt.Errorf("url must be string or object, got empty value")
+	}
+
+	switch bz[0] {
+	case '"':
+		var url string
+		if err := json.Unmarshal(bz, &url); err != nil {
+			return UrlEntry{}, err
+		}
+		return UrlEntry{Url: url}, nil
+	case '{':
+		type urlEntryObject UrlEntry
+		var object urlEntryObject
+		decoder := json.NewDecoder(bytes.NewReader(bz))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&object); err != nil {
+			return UrlEntry{}, fmt.Errorf("bad url object %s: %w", string(bz), err)
+		}
+		if object.Url == "" {
+			return UrlEntry{}, fmt.Errorf("url object must have url: %s", string(bz))
+		}
+		entry := UrlEntry(object)
+		entry.object = true
+		return entry, nil
+	default:
+		return UrlEntry{}, fmt.Errorf("url must be string or object, got %s", string(bz))
+	}
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUrlList_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		jsonContent string
+		wantUrls    []string
+		wantEntries []UrlEntry
+		wantErr     bool
+	}{
+		{
+			name:        "null",
+			jsonContent: `null`,
+			wantUrls:    nil,
+		},
+		{
+			name:        "string",
+			jsonContent: `"https://rpc.example.com"`,
+			wantUrls:    []string{"https://rpc.example.com"},
+		},
+		{
+			name:        "object",
+			jsonContent: `{"url": "https://rpc.example.com", "provider": "Example", "archive": true}`,
+			wantUrls:    []string{"https://rpc.example.com"},
+			wantEntries: []UrlEntry{{Url: "https://rpc.example.com", Provider: "Example", Archive: true, object: true}},
+		},
+		{
+			name:        "array of strings and objects",
+			jsonContent: `["https://rpc1.example.com", {"url": "https://rpc2.example.com", "provider": "Example"}]`,
+			wantUrls:    []string{"https://rpc1.example.com", "https://rpc2.example.com"},
+			wantEntries: []UrlEntry{
+				{Url: "https://rpc1.example.com"},
+				{Url: "https://rpc2.example.com", Provider: "Example", object: true},
+			},
+		},
+		{
+			name:        "empty array",
+			jsonContent: `[]`,
+			wantUrls:    nil,
+		},
+		{
+			name:        "number",
+			jsonContent: `1`,
+			wantErr:     true,
+		},
+		{
+			name:        "number in array",
+			jsonContent: `["https://rpc.example.com", 1]`,
+			wantErr:     true,
+		},
+		{
+			name:        "nested array",
+			jsonContent: `[["https://rpc.example.com"]]`,
+			wantErr:     true,
+		},
+		{
+			name:        "object without url",
+			jsonContent: `{"provider": "Example"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "object with unknown field",
+			jsonContent: `{"url": "https://rpc.example.com", "archival": true}`,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var urlList UrlList
+			require.NoError(t, json.Unmarshal([]byte(tt.jsonContent), &urlList))
+
+			if tt.wantErr {
+				require.Error(t, urlList.Err())
+				require.Empty(t, urlList.Urls())
+				return
+			}
+
+			require.NoError(t, urlList.Err())
+			require.Equal(t, tt.wantUrls, urlList.Urls())
+			if tt.wantEntries != nil {
+				require.Equal(t, tt.wantEntries, urlList.Entries)
+			}
+		})
+	}
+}
+
+func TestUrlList_MarshalJSON_PreservesForm(t *testing.T) {
+	for _, jsonContent := range []string{
+		`null`,
+		`"https://rpc.example.com"`,
+		`{"url":"https://rpc.example.com","provider":"Example","archive":true}`,
+		`{"url":"https://rpc.example.com"}`,
+		`["https://rpc.example.com"]`,
+		`[]`,
+		`["https://rpc1.example.com",{"url":"https://rpc2.example.com","archive":true}]`,
+		`[1,2]`,
+	} {
+		t.Run(jsonContent, func(t *testing.T) {
+			var urlList UrlList
+			require.NoError(t, json.Unmarshal([]byte(jsonContent), &urlList))
+
+			bz, err := json.Marshal(urlList)
+			require.NoError(t, err)
+			require.Equal(t, jsonContent, string(bz))
+		})
+	}
+}
+
+func TestUrlList_MarshalJSON_ChainDefinition(t *testing.T) {
+	jsonContent := `{"rpc": {"url": "https://rpc.example.com", "archive": true}, "rest": ["https://rest.example.com"], "evm": {"chainId": "0x1", "rpc": "https://evm.example.com"}}`
+
+	var chainDefinition ChainDefinition
+	require.NoError(t, json.Unmarshal([]byte(jsonContent), &chainDefinition))
+
+	bz, err := json.Marshal(chainDefinition)
+	require.NoError(t, err)
+
+	var reDecoded map[string]any
+	require.NoError(t, json.Unmarshal(bz, &reDecoded))
+	require.Equal(t, map[string]any{"url": "https://rpc.example.com", "archive": true}, reDecoded["rpc"])
+	require.Equal(t, []any{"https://rest.example.com"}, reDecoded["rest"])
+	require.Nil(t, reDecoded["beRpc"])
+	require.Equal(t, "https://evm.example.com", reDecoded["evm"].(map[string]any)["rpc"])
+}
+
+func TestNewUrlList(t *testing.T) {
+	bz, err := json.Marshal(NewUrlList("https://rpc.example.com"))
+	require.NoError(t, err)
+	require.Equal(t, `"https://rpc.example.com"`, string(bz))
+
+	bz, err = json.Marshal(NewUrlList("https://rpc1.example.com", "https://rpc2.example.com"))
+	require.NoError(t, err)
+	require.Equal(t, `["https://rpc1.example.com","https://rpc2.example.com"]`, string(bz))
+}
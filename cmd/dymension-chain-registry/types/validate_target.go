@@ -1,6 +1,10 @@
 package types
 
-import "strings"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
 
 type ValidateTarget string
 
@@ -11,6 +15,11 @@ const (
 	ValidateInternalDevnet ValidateTarget = "internal-devnet"
 )
 
+// BuiltInValidateTargets returns the groups which are validated when the registry does not declare its groups
+func BuiltInValidateTargets() []ValidateTarget {
+	return []ValidateTarget{ValidateMainnet, ValidateTestnet, ValidateDevnet, ValidateInternalDevnet}
+}
+
 func (vt ValidateTarget) String() string {
 	switch vt {
 	case ValidateInternalDevnet:
@@ -23,3 +32,14 @@ func (vt ValidateTarget) String() string {
 func (vt ValidateTarget) SubDirectoryName() string {
 	return string(vt)
 }
+
+// Validate ensures the group name is a lowercase directory name, words are separated by dash.
+func (vt ValidateTarget) Validate() error {
+	if vt == "" {
+		return fmt.Errorf("group name must not be empty")
+	}
+	if !regexp.MustCompile(`^[a-z\d]+(-[a-z\d]+)*$`).MatchString(string(vt)) {
+		return fmt.Errorf("group name must be lowercase alphanumeric, words separated by dash: %s", vt)
+	}
+	return nil
+}
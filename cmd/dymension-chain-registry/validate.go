@@ -10,7 +10,6 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
-	"strconv"
 	"strings"
 )
 
@@ -19,11 +18,23 @@ const (
 	flagTestnet                   = "testnet"
 	flagDevnet                    = "devnet"
 	flagInternalDevnet            = "internal-devnet"
+	flagGroup                     = "group"
 	flagStopOnFirstErr            = "stop-on-error"
 	flagAdditionChainTypesAllowed = "addition-chain-types-allowed"
+	flagRuleConfig                = "rule-config"
+	flagGitBaseRef                = "git-base-ref"
 )
 
 var validationErrors []string
+var validationWarnings []string
+
+// validateOptions holds the settings which are applied to validation of every group
+type validateOptions struct {
+	stopOnFirstErr              bool
+	additionalChainTypesAllowed []string
+	ruleConfig                  valtypes.RuleConfig
+	gitBaseRef                  string
+}
 
 func GetValidateCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,47 +43,16 @@ func GetValidateCommand() *cobra.Command {
 		Short:   "Validate Dymension chain-registry",
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			var validateMainnet, validateTestnet, validateDevnet, validateInternalDevnet bool
-
-			if cmd.Flags().Changed(flagMainnet) {
-				validateMainnet = true
-			}
-			if cmd.Flags().Changed(flagTestnet) {
-				validateTestnet = true
-			}
-			if cmd.Flags().Changed(flagDevnet) {
-				validateDevnet = true
-			}
-			if cmd.Flags().Changed(flagInternalDevnet) {
-				validateInternalDevnet = true
-			}
-
 			stopOnFirstError := cmd.Flags().Changed(flagStopOnFirstErr)
 
 			additionalChainTypesAllowed, _ := cmd.Flags().GetStringArray(flagAdditionChainTypesAllowed)
 
-			if !validateMainnet && !validateTestnet && !validateDevnet && !validateInternalDevnet {
-				// no flag provided, validate all
-				validateMainnet = true
-				validateTestnet = true
-				validateDevnet = true
-				validateInternalDevnet = true
+			options := validateOptions{
+				stopOnFirstErr:              stopOnFirstError,
+				additionalChainTypesAllowed: additionalChainTypesAllowed,
+				ruleConfig:                  loadRuleConfigFromFlag(cmd),
 			}
-
-			fmt.Printf("Going to validate")
-			if validateMainnet {
-				fmt.Printf(" %s", valtypes.ValidateMainnet)
-			}
-			if validateTestnet {
-				fmt.Printf(" %s", valtypes.ValidateTestnet)
-			}
-			if validateDevnet {
-				fmt.Printf(" %s", valtypes.ValidateDevnet)
-			}
-			if validateInternalDevnet {
-				fmt.Printf(" %s", valtypes.ValidateInternalDevnet)
-			}
-			fmt.Println()
+			options.gitBaseRef, _ = cmd.Flags().GetString(flagGitBaseRef)
 
 			repoDir := args[0]
 
@@ -91,17 +71,34 @@ func GetValidateCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
-			if validateMainnet {
-				validateChainRegistry(repoDir, valtypes.ValidateMainnet, stopOnFirstError, additionalChainTypesAllowed)
+			if options.gitBaseRef != "" && !isValidGitRef(repoDir, options.gitBaseRef) {
+				os.Exit(1)
 			}
-			if validateTestnet {
-				validateChainRegistry(repoDir, valtypes.ValidateTestnet, stopOnFirstError, additionalChainTypesAllowed)
+
+			registryGroups, declared, err := valtypes.LoadRegistryGroups(repoDir)
+			if err != nil {
+				utils.PrintlnStdErr("ERR: Failed to load groups of the registry:", err)
+				os.Exit(1)
+			}
+
+			targets := getTargetsFromFlags(cmd, registryGroups, declared)
+
+			fmt.Printf("Going to validate")
+			for _, target := range targets {
+				fmt.Printf(" %s", target)
 			}
-			if validateDevnet {
-				validateChainRegistry(repoDir, valtypes.ValidateDevnet, stopOnFirstError, additionalChainTypesAllowed)
+			fmt.Println()
+
+			for _, target := range targets {
+				validateChainRegistry(repoDir, target, options)
 			}
-			if validateInternalDevnet {
-				validateChainRegistry(repoDir, valtypes.ValidateInternalDevnet, stopOnFirstError, additionalChainTypesAllowed)
+
+			if len(validationWarnings) > 0 {
+				utils.PrintlnStdErr("Warnings:")
+				for _, warn := range validationWarnings {
+					utils.PrintlnStdErr(">", warn)
+				}
+				utils.PrintlnStdErr("Total", len(validationWarnings), "warnings found!")
 			}
 
 			if len(validationErrors) > 0 {
@@ -117,17 +114,99 @@ func GetValidateCommand() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().Bool(flagMainnet, false, "validate mainnet records only")
-	cmd.Flags().Bool(flagTestnet, false, "validate testnet records only")
-	cmd.Flags().Bool(flagDevnet, false, "validate devnet records only")
-	cmd.Flags().Bool(flagInternalDevnet, false, "validate internal-devnet records only")
+	addTargetFlags(cmd, "validate")
 	cmd.Flags().BoolP(flagStopOnFirstErr, "e", false, "stop on first error")
 	cmd.Flags().StringArray(flagAdditionChainTypesAllowed, nil, "allow additional chain types")
+	cmd.Flags().String(flagRuleConfig, "", "path to the JSON file which overrides the default validation rules")
+	cmd.Flags().String(flagGitBaseRef, "", "git ref to compare chain ids with, revision of chain ids must not decrease since the ref")
 
 	return cmd
 }
 
-func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, stopOnFirstErr bool, additionalChainTypesAllowed []string) {
+func addTargetFlags(cmd *cobra.Command, verb string) {
+	cmd.Flags().StringArray(flagGroup, nil, verb+" records of the group only, can be repeated")
+	cmd.Flags().Bool(flagMainnet, false, verb+" mainnet records only, shorthand for --"+flagGroup+" "+string(valtypes.ValidateMainnet))
+	cmd.Flags().Bool(flagTestnet, false, verb+" testnet records only, shorthand for --"+flagGroup+" "+string(valtypes.ValidateTestnet))
+	cmd.Flags().Bool(flagDevnet, false, verb+" devnet records only, shorthand for --"+flagGroup+" "+string(valtypes.ValidateDevnet))
+	cmd.Flags().Bool(flagInternalDevnet, false, verb+" internal-devnet records only, shorthand for --"+flagGroup+" "+string(valtypes.ValidateInternalDevnet))
+}
+
+// getTargetsFromFlags returns the groups selected by flags, all available groups when no flag provided.
+// When the registry declares its groups, selected groups must be declared.
+func getTargetsFromFlags(cmd *cobra.Command, availableGroups []valtypes.ValidateTarget, declared bool) []valtypes.ValidateTarget {
+	var selectedGroups []valtypes.ValidateTarget
+	for _, shorthand := range []valtypes.ValidateTarget{
+		valtypes.ValidateMainnet,
+		valtypes.ValidateTestnet,
+		valtypes.ValidateDevnet,
+		valtypes.ValidateInternalDevnet,
+	} {
+		if cmd.Flags().Changed(string(shorthand)) {
+			selectedGroups = append(selectedGroups, shorthand)
+		}
+	}
+	groups, _ := cmd.Flags().GetStringArray(flagGroup)
+	for _, group := range groups {
+		selectedGroups = append(selectedGroups, valtypes.ValidateTarget(strings.TrimSpace(group)))
+	}
+
+	if len(selectedGroups) == 0 {
+		// no flag provided, all groups
+		return availableGroups
+	}
+
+	isAvailable := make(map[valtypes.ValidateTarget]bool)
+	for _, group := range availableGroups {
+		isAvailable[group] = true
+	}
+
+	var targets []valtypes.ValidateTarget
+	uniqueTargetTracker := make(map[valtypes.ValidateTarget]bool)
+	for _, target := range selectedGroups {
+		if err := target.Validate(); err != nil {
+			utils.PrintlnStdErr("ERR: Bad group:", err)
+			os.Exit(1)
+		}
+		if declared && !isAvailable[target] {
+			utils.PrintlnStdErr("ERR: Group", target.SubDirectoryName(), "is not declared in", valtypes.RegistryGroupsFileName, "of the registry")
+			os.Exit(1)
+		}
+		if uniqueTargetTracker[target] {
+			continue
+		}
+		uniqueTargetTracker[target] = true
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+// loadRuleConfigFromFlag loads the rule config file provided via flag, or the default rule set when not provided.
+func loadRuleConfigFromFlag(cmd *cobra.Command) valtypes.RuleConfig {
+	ruleConfigFile, _ := cmd.Flags().GetString(flagRuleConfig)
+	if ruleConfigFile == "" {
+		return valtypes.DefaultRuleConfig()
+	}
+
+	ruleConfig, err := valtypes.LoadRuleConfig(ruleConfigFile)
+	if err != nil {
+		utils.PrintlnStdErr("ERR: Failed to load rule config file:", err)
+		os.Exit(1)
+	}
+
+	for target, policy := range ruleConfig.Groups {
+		for _, da := range policy.ForbiddenDA {
+			if _, found := daProviderByName(da); !found {
+				utils.PrintlnStdErr("ERR: Failed to load rule config file: not recognized forbidden DA", da, "of group", target)
+				os.Exit(1)
+			}
+		}
+	}
+
+	return ruleConfig
+}
+
+func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, options validateOptions) {
 	fmt.Println("Validating group", target.String(), "...")
 
 	var workingChain string
@@ -151,13 +230,32 @@ func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, stopO
 			utils.PrintlnStdErr()
 		}
 
-		if stopOnFirstErr {
+		if options.stopOnFirstErr {
 			os.Exit(1)
 		}
 
 		validationErrors = append(validationErrors, fmt.Sprint(errMsgParts...))
 	}
 
+	markWarn := func(a ...any) {
+		prefixes := []any{"WARN:", fmt.Sprintf("[group:%s]", target.String())}
+		if len(workingChain) > 0 {
+			prefixes = append(prefixes, fmt.Sprintf("[chain:%s]", workingChain))
+		}
+
+		warnMsgParts := append(prefixes, a...)
+
+		utils.PrintlnStdErr(warnMsgParts...)
+
+		if len(workingFile) > 0 {
+			utils.PrintlnStdErr("File:", workingFile)
+			warnMsgParts = append(warnMsgParts, ", File: ", workingFile)
+		}
+		utils.PrintlnStdErr()
+
+		validationWarnings = append(validationWarnings, fmt.Sprint(warnMsgParts...))
+	}
+
 	subDirPath := path.Join(repoDir, target.SubDirectoryName())
 	di, err := os.Stat(subDirPath)
 	if err != nil {
@@ -174,6 +272,14 @@ func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, stopO
 	}
 
 	uniqueChainIdTracker := make(map[string]string)
+	caseInsensitiveDirNameTracker := make(map[string]string)
+	index := &registryIndex{}
+	allowedChainTypes := chainTypeAllowLists(options)
+	groupPolicy := options.ruleConfig.GroupPolicy(target)
+	urlPolicy := urlPolicy{
+		requireSecure:     groupPolicy.RequireHttps,
+		allowPrivateHosts: groupPolicy.AllowPrivateHosts,
+	}
 
 	_ = filepath.WalkDir(subDirPath, func(filePath string, d os.DirEntry, _ error) error {
 		if !d.IsDir() {
@@ -196,6 +302,15 @@ func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, stopO
 		//fmt.Println("> Validating", filePath)
 
 		workingChain = spl[0]
+		workingFile = ""
+
+		if !options.ruleConfig.DirectoryName.AllowCaseOnlyCollision {
+			if existing, found := caseInsensitiveDirNameTracker[strings.ToLower(workingChain)]; found {
+				markErr("Directory names only different in letter case, it breaks checkout on case-insensitive file systems:", existing, "and", workingChain)
+			} else {
+				caseInsensitiveDirNameTracker[strings.ToLower(workingChain)] = workingChain
+			}
+		}
 
 		chainDefinitionFile := path.Join(filePath, workingChain+".json")
 
@@ -229,52 +344,73 @@ func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, stopO
 			return nil
 		}
 		uniqueChainIdTracker[cd.ChainId] = workingChain
+		index.add(workingChain, filePath, chainDefinitionFile, cd)
 
 		if !isValidChainId(cd.ChainId, cd.IsRollAppChain() && cd.EVM != nil) {
 			markErr("Bad chain id:", cd.ChainId)
+		} else if options.gitBaseRef != "" && !isValidChainIdRevisionBump(repoDir, options.gitBaseRef, chainDefinitionFile, cd) {
+			markErr("Bad chain id revision:", cd.ChainId)
 		}
 
 		if !isValidChainName(cd.ChainName) {
 			markErr("Bad chain name:", cd.ChainName)
 		}
 
+		if !isValidChainDirectoryName(workingChain, cd, options.ruleConfig.DirectoryName) {
+			markErr("Bad directory name:", workingChain)
+		}
+
 		rpcUrls, err := cd.GetRpcUrls()
 		if err != nil {
 			markErr("Failed to get RPC urls:", err)
-		} else if !isValidUrls(rpcUrls) {
+		} else if !isValidUrls(rpcUrls, urlPolicy.withSchemes(httpOrWsUrlSchemes)) {
 			markErr("Bad RPC urls:", rpcUrls)
 		}
 
 		restUrls, err := cd.GetRestUrls()
 		if err != nil {
 			markErr("Failed to get REST urls:", err)
-		} else if !isValidUrls(restUrls) {
+		} else if !isValidUrls(restUrls, urlPolicy.withSchemes(httpUrlSchemes)) {
 			markErr("Bad REST urls:", restUrls)
 		}
 
 		beRpcUrls, err := cd.GetBeRpcUrls()
 		if err != nil {
 			markErr("Failed to get Be RPC urls:", err)
-		} else if !isValidUrls(beRpcUrls) {
+		} else if !isValidUrls(beRpcUrls, urlPolicy.withSchemes(httpUrlSchemes)) {
 			markErr("Bad Be RPC urls:", beRpcUrls)
 		}
 
-		if cd.IsRollAppChain() {
-			if cd.Bech32Prefix == "" {
-				markErr("Bech32 prefix is required for RollApp chains")
-			}
+		chainType, validChainType := isValidChainType(cd.Type, allowedChainTypes, options.ruleConfig.ChainTypeMatching.CaseInsensitive)
+		if !validChainType {
+			markErr("Bad chain type:", cd.Type)
+		}
+
+		for _, issue := range chainTypeRequirementIssues(cd, chainType, options.ruleConfig.ChainTypes) {
+			markErr(issue)
+		}
+
+		policyIssues, policyWarnings := groupPolicyIssues(cd, target, groupPolicy)
+		for _, issue := range policyIssues {
+			markErr(issue)
+		}
+		for _, warning := range policyWarnings {
+			markWarn(warning)
 		}
+
 		if cd.Bech32Prefix != "" {
 			if !isValidBech32Prefix(cd.Bech32Prefix) {
 				markErr("Bad Bech32 prefix:", cd.Bech32Prefix)
+			} else if cd.IsRollAppChain() && !isUnclaimedBech32Prefix(cd) {
+				markErr("Bad Bech32 prefix, already owned by another chain:", cd.Bech32Prefix)
 			}
 		}
 
-		if !isValidOptionalWebsiteUrl(cd.WebSite) {
+		if !isValidOptionalWebsiteUrl(cd.WebSite, urlPolicy) {
 			markErr("Bad website url:", cd.WebSite)
 		}
 
-		if !isValidDA(cd) {
+		if !isValidDA(cd, target, groupPolicy) {
 			markErr("Bad DA:", cd.DA)
 		}
 
@@ -286,40 +422,69 @@ func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, stopO
 			evmRpcUrls, err := cd.GetEvmRpcUrls()
 			if err != nil {
 				markErr("Failed to get EVM RPC urls:", err)
-			} else if !isValidUrls(evmRpcUrls) {
+			} else if !isValidUrls(evmRpcUrls, urlPolicy.withSchemes(httpOrWsUrlSchemes)) {
 				markErr("Bad EVM RPC urls:", evmRpcUrls)
 			}
 
-			if !isValidEvmHexChainId(cd) {
-				markErr("Bad EVM hex chain id:", cd.EVM.ChainId)
+			if !isValidEvmChainId(cd) {
+				markErr("Bad EVM chain id:", cd.EVM.ChainId)
 			}
 		}
 
 		if len(cd.Currencies) > 0 {
-			if valid, identity := isValidCurrencies(cd.Currencies, filePath, cd.Type); !valid {
+			if valid, identity := isValidCurrencies(cd.Currencies, filePath, repoDir, options.ruleConfig.Logo); !valid {
 				if identity == "" {
 					markErr("Bad currencies")
 				} else {
 					markErr("Bad currencies:", identity)
 				}
 			}
-		} else {
-			markErr("Currencies is required")
+		}
+
+		for _, currency := range cd.Currencies {
+			if currency.BaseDenom != "" && !isValidStructuredDenom(currency.BaseDenom, cd) {
+				markErr("Bad base denom:", currency.BaseDenom)
+			}
+			if currency.BridgeDenom != "" && !isValidStructuredDenom(currency.BridgeDenom, cd) {
+				markErr("Bad bridge denom:", currency.BridgeDenom)
+			}
+			if warning := nativeDenomDecimalsWarning(currency); warning != "" {
+				markWarn(warning)
+			}
+			if chainType == valtypes.ChainTypeSolana && currency.BridgeDenom != "" && !isValidSolanaMintAddress(currency.BridgeDenom) {
+				markErr("Bad Solana mint address bridge denom:", currency.BridgeDenom)
+			}
+			for _, denom := range []string{currency.BaseDenom, currency.BridgeDenom} {
+				if address, found := evmContractAddressOfDenom(denom, cd, chainType); found {
+					if !isValidEvmContractAddress(address) {
+						markErr("Bad EVM contract address denom:", denom)
+					} else if warning := evmContractAddressChecksumWarning(address); warning != "" {
+						markWarn(warning)
+					}
+				}
+			}
+		}
+
+		if chainType == valtypes.ChainTypeSolana && cd.CoinType == 0 {
+			markWarn("Coin type is not set, Solana chains should use coin type", solanaCoinType)
 		}
 
 		if cd.IsEvmRollApp() {
 			if cd.CoinType != 60 {
 				markErr("Coin type must be 60 for EVM RollApp chains")
 			}
-		} else if !isValidCoinType(cd.CoinType, cd.Type) {
+		} else if !isValidCoinType(cd.CoinType, chainType) {
 			markErr("Bad coin type:", cd.CoinType)
 		}
+		if warning := coinTypeRegistryWarning(cd); warning != "" {
+			markWarn(warning)
+		}
 
 		if !isValidGasAdjustment(cd.GasAdjustment) {
 			markErr("Bad gas adjustment:", cd.GasAdjustment)
 		}
 
-		if !isValidOptionalWebsiteUrl(cd.FaucetUrl) {
+		if !isValidOptionalWebsiteUrl(cd.FaucetUrl, urlPolicy) {
 			markErr("Bad faucet url:", cd.FaucetUrl)
 		}
 
@@ -335,103 +500,33 @@ func validateChainRegistry(repoDir string, target valtypes.ValidateTarget, stopO
 			}
 		}
 
-		if !isValidLogo(cd.Logo, filePath) {
+		if !isValidLogo(cd.Logo, filePath, repoDir, options.ruleConfig.Logo) {
 			markErr("Bad chain logo:", cd.Logo)
 		}
 
-		if !isValidChainType(cd.Type, additionalChainTypesAllowed) {
-			markErr("Bad chain type:", cd.Type)
-		}
-
-		if cd.Goldberg && cd.DA != "Avail" {
-			markErr("Goldberg when set, DA must be Avail")
+		if !isDAForbiddenByChainType(chainType, options.ruleConfig.ChainTypes) && !isValidDAFields(cd) {
+			markErr("Bad DA specific fields of DA:", cd.DA)
 		}
 
-		if !isValidAvailAddress(cd.AvailAddress, cd.DA) {
-			markErr("Bad avail address:", cd.AvailAddress)
+		for _, issue := range findStrayFiles(filePath, chainDefinitionFile, cd, options.ruleConfig.StrayFiles) {
+			markErr("Stray file:", issue)
 		}
 
 		return nil
 	})
-}
-
-func isValidAvailAddress(availAddress string, da string) bool {
-	if da != "Avail" {
-		if availAddress != "" {
-			utils.PrintlnStdErr("ERR: Avail address is only available if DA is Avail")
-			return false
-		}
-		return true
-	}
-
-	if availAddress == "" {
-		return true
-	}
 
-	if strings.Contains(availAddress, " ") {
-		utils.PrintlnStdErr("ERR: Avail address must not contains space")
-		return false
-	}
-
-	if !strings.HasPrefix(availAddress, "5") {
-		utils.PrintlnStdErr("ERR: Avail address must start with 5")
-		return false
-	}
-
-	if !regexp.MustCompile(`^5[a-zA-Z\d]+$`).MatchString(availAddress) {
-		utils.PrintlnStdErr("ERR: Avail address must starts with 5, followed by alphanumeric characters")
-		return false
-	}
-
-	if len(availAddress) != 48 {
-		utils.PrintlnStdErr("ERR: Avail address must be 48 characters long")
-		return false
-	}
-
-	return true
-}
+	var registryIssues []registryIssue
+	registryIssues = append(registryIssues, findDuplicatedLogos(index, repoDir, options.ruleConfig.Logo)...)
+	registryIssues = append(registryIssues, findConfusableNames(index)...)
+	registryIssues = append(registryIssues, findTickerCollisions(index, options.ruleConfig.TickerCollision)...)
+	registryIssues = append(registryIssues, findDecimalsMismatches(index)...)
+	registryIssues = append(registryIssues, findEvmChainIdCollisions(index)...)
+	registryIssues = append(registryIssues, findChainIdEvmConflicts(index)...)
 
-func isValidChainType(chainType string, additionalChainTypesAllowed []string) bool {
-	if chainType == "" {
-		utils.PrintlnStdErr("ERR: Chain type is required")
-		return false
-	}
-
-	switch chainType {
-	case "RollApp", "Regular", "EVM", "Hub", "Solana":
-		return true
-	default:
-		for _, ct := range additionalChainTypesAllowed {
-			if ct == ct {
-				return true
-			}
-		}
-		utils.PrintlnStdErr("ERR: Not recognized chain type:", chainType, fmt.Sprintf("(consider provide into --%s flag)", flagAdditionChainTypesAllowed))
-		return false
-	}
-}
-
-func isValidLogo(logo string, chainPath string) bool {
-	if logo == "" {
-		return true
-	}
-	logoPath := path.Join(chainPath, logo)
-	_, err := os.Stat(logoPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			utils.PrintlnStdErr("ERR: Logo file not found:", logoPath)
-			return false
-		}
-		utils.PrintlnStdErr("ERR: Failed to get stat of logo file:", logoPath, err)
-		return false
-	}
-	ext := strings.ToLower(filepath.Ext(logoPath))
-	switch ext {
-	case ".png", ".jpg", ".jpeg", ".svg":
-		return true
-	default:
-		utils.PrintlnStdErr("ERR: Logo file must be PNG, JPG, JPEG, or SVG:", logoPath)
-		return false
+	for _, issue := range registryIssues {
+		workingChain = issue.chain.dirName
+		workingFile = issue.chain.chainDefinitionFile
+		markErr(issue.message...)
 	}
 }
 
@@ -538,25 +633,19 @@ func isValidGasAdjustment(gasAdjustment float64) bool {
 	return true
 }
 
-func isValidCoinType(coinType int64, chainType string) bool {
+func isValidCoinType(coinType int64, chainType valtypes.ChainType) bool {
 	if coinType < 0 {
 		utils.PrintlnStdErr("ERR: Coin type must be non-negative")
 		return false
 	}
-	switch chainType {
-	case "EVM", "Solana":
-		return true
-	default:
-		if coinType == 0 {
-			utils.PrintlnStdErr("ERR: Coin type must be positive")
-			return false
-
-		}
+	if chainType == valtypes.ChainTypeSolana && coinType != 0 && coinType != solanaCoinType {
+		utils.PrintlnStdErr("ERR: Coin type must be", solanaCoinType, "for Solana chains")
+		return false
 	}
 	return true
 }
 
-func isValidCurrencies(currencies []valtypes.CurrencyChainDefinition, chainPath string, chainType string) (valid bool, identity string) {
+func isValidCurrencies(currencies []valtypes.CurrencyChainDefinition, chainPath string, repoDir string, logoRules valtypes.LogoRuleConfig) (valid bool, identity string) {
 	var foundMain bool
 
 	uniqueBaseDenomTracker := make(map[string]bool)
@@ -564,7 +653,7 @@ func isValidCurrencies(currencies []valtypes.CurrencyChainDefinition, chainPath
 	uniqueIbcRepresentationTracker := make(map[string]bool)
 
 	for _, currency := range currencies {
-		if !isValidCurrency(currency, chainPath, chainType) {
+		if !isValidCurrency(currency, chainPath, repoDir, logoRules) {
 			var descCurrency string
 			bz, err := json.Marshal(currency)
 			if err != nil {
@@ -620,7 +709,7 @@ func isValidCurrencies(currencies []valtypes.CurrencyChainDefinition, chainPath
 	return true, ""
 }
 
-func isValidCurrency(currency valtypes.CurrencyChainDefinition, chainPath string, chainType string) bool {
+func isValidCurrency(currency valtypes.CurrencyChainDefinition, chainPath string, repoDir string, logoRules valtypes.LogoRuleConfig) bool {
 	if currency.DisplayDenom == "" {
 		utils.PrintlnStdErr("ERR: Display denom is required")
 		return false
@@ -633,6 +722,9 @@ func isValidCurrency(currency valtypes.CurrencyChainDefinition, chainPath string
 		utils.PrintlnStdErr("ERR: Display denom must not have consecutive spaces")
 		return false
 	}
+	if !isSafeUnicodeText("Display denom", currency.DisplayDenom) {
+		return false
+	}
 	if !regexp.MustCompile(`^[a-zA-Z\d\s-_]+$`).MatchString(currency.DisplayDenom) {
 		utils.PrintlnStdErr("ERR: Display denom must be alphanumeric, space, underscore, or dash")
 		return false
@@ -701,12 +793,6 @@ func isValidCurrency(currency valtypes.CurrencyChainDefinition, chainPath string
 			utils.PrintlnStdErr("ERR: Bridge denom must be alphanumeric, space, underscore, dash, or slash")
 			return false
 		}
-	} else {
-		switch chainType {
-		case "EVM", "Solana":
-			utils.PrintlnStdErr("ERR: Bridge denom is required for EVM and Solana chains")
-			return false
-		}
 	}
 	if currency.Decimals < 0 {
 		utils.PrintlnStdErr("ERR: Decimals must be non-negative")
@@ -716,7 +802,7 @@ func isValidCurrency(currency valtypes.CurrencyChainDefinition, chainPath string
 		utils.PrintlnStdErr("ERR: Decimals must not exceed 18")
 		return false
 	}
-	if !isValidLogo(currency.Logo, chainPath) {
+	if !isValidLogo(currency.Logo, chainPath, repoDir, logoRules) {
 		utils.PrintlnStdErr("ERR: Bad currency logo:", currency.Logo)
 		return false
 	}
@@ -732,86 +818,6 @@ func isValidCurrency(currency valtypes.CurrencyChainDefinition, chainPath string
 	}
 }
 
-func isValidEvmHexChainId(cd valtypes.ChainDefinition) bool {
-	if !regexp.MustCompile(`^0x[a-fA-F\d]+$`).MatchString(cd.EVM.ChainId) {
-		utils.PrintlnStdErr("ERR: EVM hex chain id must be 0x followed by hexadecimal characters")
-		return false
-	}
-
-	var checkWithCosmosChainId bool
-	if cd.IsRollAppChain() {
-		checkWithCosmosChainId = true
-	} else if regexp.MustCompile(`^[a-z\d]+_\d+-\d+$`).MatchString(cd.ChainId) {
-		checkWithCosmosChainId = true
-	}
-
-	if checkWithCosmosChainId {
-		spl := strings.Split(cd.ChainId, "_")
-		if len(spl) != 2 {
-			utils.PrintlnStdErr("ERR: EVM RollApp chain id must have format <alphanumeric>_<number>-<number>")
-			return false
-		}
-		spl = strings.Split(spl[1], "-")
-		chainIdFromCosmos, err := strconv.ParseInt(spl[0], 10, 64)
-		if err != nil {
-			panic(err)
-		}
-		chainIdFromEvm, err := strconv.ParseInt(cd.EVM.ChainId, 0, 64)
-		if err != nil {
-			panic(err)
-		}
-		if chainIdFromCosmos != chainIdFromEvm {
-			utils.PrintfStdErr("ERR: EVM hex chain id %d must match with the chain id from cosmos chain id %d\n", chainIdFromEvm, chainIdFromCosmos)
-			return false
-		}
-	}
-
-	return true
-}
-
-func isValidDA(cd valtypes.ChainDefinition) bool {
-	if !cd.IsRollAppChain() {
-		if cd.DA != "" {
-			utils.PrintlnStdErr("ERR: DA must be empty for non-RollApp chains")
-			return false
-		}
-		return true
-	}
-	if cd.IsRollAppChain() && cd.DA == "" {
-		utils.PrintlnStdErr("ERR: DA is required for RollApp chains")
-		return false
-	}
-	switch cd.DA {
-	case "Avail":
-		return true
-	case "Celestia":
-		return true
-	case "local":
-		return true
-	default:
-		utils.PrintlnStdErr("ERR: DA must be one of: 'Avail', 'Celestia', 'local'")
-		return false
-	}
-}
-
-func isValidOptionalWebsiteUrl(websiteUrl string) bool {
-	if websiteUrl == "" {
-		return true
-	}
-
-	if strings.TrimSpace(websiteUrl) != websiteUrl {
-		utils.PrintlnStdErr("ERR: url must not have leading or trailing spaces")
-		return false
-	}
-
-	if strings.Contains(websiteUrl, " ") {
-		utils.PrintlnStdErr("ERR: url must not contains space")
-		return false
-	}
-
-	return true
-}
-
 func isValidBech32Prefix(bech32Prefix string) bool {
 	if bech32Prefix == "" {
 		utils.PrintlnStdErr("ERR: bech32 prefix can not be empty")
@@ -837,32 +843,8 @@ func isValidBech32Prefix(bech32Prefix string) bool {
 		utils.PrintlnStdErr("ERR: bech32 prefix must be lowercase alphanumeric")
 		return false
 	}
-	return true
-}
-
-func isValidUrls(urls []string) bool {
-	if len(urls) == 1 && urls[0] == "" {
-		return true
-	}
-	for _, url := range urls {
-		if !isValidUrl(url) {
-			return false
-		}
-	}
-	return true
-}
-
-func isValidUrl(url string) bool {
-	if url == "" {
-		utils.PrintlnStdErr("ERR: url can not be empty")
-		return false
-	}
-	if strings.TrimSpace(url) != url {
-		utils.PrintlnStdErr("ERR: url must not have leading or trailing spaces")
-		return false
-	}
-	if strings.Contains(url, " ") {
-		utils.PrintlnStdErr("ERR: url must not contains space")
+	if len(bech32Prefix) > utils.MaxBech32HrpLength {
+		utils.PrintlnStdErr("ERR: bech32 prefix must not exceed", utils.MaxBech32HrpLength, "characters")
 		return false
 	}
 	return true
@@ -881,6 +863,9 @@ func isValidChainName(chainName string) bool {
 		utils.PrintlnStdErr("ERR: chain name must not have consecutive spaces")
 		return false
 	}
+	if !isSafeUnicodeText("Chain name", chainName) {
+		return false
+	}
 	if regexp.MustCompile(`[<>/\\%]`).MatchString(chainName) {
 		// < > to prevent xss
 		// / \ % to prevent path traversal and conflict
@@ -916,6 +901,10 @@ func isValidChainId(chainId string, isEvmRollApp bool) bool {
 		utils.PrintlnStdErr("ERR: chain id must start with a letter")
 		return false
 	}
+	if err := valtypes.ParseChainId(chainId).Validate(); err != nil {
+		utils.PrintlnStdErr("ERR:", err)
+		return false
+	}
 	if isEvmRollApp {
 		valid := regexp.MustCompile(`^[a-z\d]+_\d+-\d+$`).MatchString(chainId)
 		if !valid {
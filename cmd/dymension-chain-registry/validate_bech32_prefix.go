@@ -0,0 +1,18 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"github.com/bcdevtools/chain-registry-validation-tool/wellknown"
+)
+
+// isUnclaimedBech32Prefix checks the bech32 prefix of the chain is not a well-known prefix owned by another chain,
+// addresses of both chains would be indistinguishable.
+func isUnclaimedBech32Prefix(cd valtypes.ChainDefinition) bool {
+	owner, found := wellknown.LookupBech32PrefixOwner(cd.Bech32Prefix)
+	if !found || owner.IsOwnerChainIdPrefix(cd.ChainIdPrefix()) {
+		return true
+	}
+	utils.PrintlnStdErr("ERR: Bech32 prefix", cd.Bech32Prefix, "is owned by", owner.ChainName, "and must not be claimed by other chains")
+	return false
+}
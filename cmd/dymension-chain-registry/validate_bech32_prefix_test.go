@@ -0,0 +1,30 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_isUnclaimedBech32Prefix(t *testing.T) {
+	tests := []struct {
+		name         string
+		chainId      string
+		bech32Prefix string
+		want         bool
+	}{
+		{name: "not well-known prefix", chainId: "rollappx_1234-1", bech32Prefix: "rollappx", want: true},
+		{name: "owner", chainId: "dymension_1100-1", bech32Prefix: "dym", want: true},
+		{name: "owner testnet", chainId: "blumbus_111-1", bech32Prefix: "dym", want: true},
+		{name: "owner without revision", chainId: "dymension", bech32Prefix: "dym", want: true},
+		{name: "other chain", chainId: "rollappx_1234-1", bech32Prefix: "dym", want: false},
+		{name: "lookalike chain id", chainId: "dymensionfake_1-1", bech32Prefix: "dym", want: false},
+		{name: "short owner chain id prefix", chainId: "uni-6", bech32Prefix: "juno", want: true},
+		{name: "longer name starting with short owner chain id prefix", chainId: "universe_1234-1", bech32Prefix: "juno", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isUnclaimedBech32Prefix(valtypes.ChainDefinition{ChainId: tt.chainId, Bech32Prefix: tt.bech32Prefix}))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package dymension_chain_registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// findChainIdEvmConflicts reports chains of the group which chain id has the same base name
+// as another chain but a different EVM chain id part, e.g. "foo_100-1" and "foo_200-1".
+func findChainIdEvmConflicts(index *registryIndex) (issues []registryIssue) {
+	chainOfName := make(map[string]*indexedChain)
+	for _, chain := range index.chains {
+		parsedChainId := valtypes.ParseChainId(chain.cd.ChainId)
+		if parsedChainId.EvmChainId == "" {
+			continue
+		}
+		existing, found := chainOfName[parsedChainId.Name]
+		if !found {
+			chainOfName[parsedChainId.Name] = chain
+			continue
+		}
+		if existingEvmChainId := valtypes.ParseChainId(existing.cd.ChainId).EvmChainId; existingEvmChainId != parsedChainId.EvmChainId {
+			issues = append(issues, registryIssue{
+				chain: chain,
+				message: []any{fmt.Sprintf(
+					"Chain id %s uses base name %s with EVM chain id %s, but chain %s uses it with EVM chain id %s",
+					chain.cd.ChainId, parsedChainId.Name, parsedChainId.EvmChainId, existing.dirName, existingEvmChainId,
+				)},
+			})
+		}
+	}
+	return
+}
+
+// isValidGitRef checks the git ref can be resolved to a commit of the repository containing the registry
+func isValidGitRef(repoDir string, gitRef string) bool {
+	output, err := exec.Command("git", "-C", repoDir, "rev-parse", "--verify", "--quiet", gitRef+"^{commit}").CombinedOutput()
+	if err != nil {
+		utils.PrintlnStdErr("ERR: Failed to resolve git ref", gitRef, "in", repoDir+":", err, strings.TrimSpace(string(output)))
+		return false
+	}
+	return true
+}
+
+// isValidChainIdRevisionBump compares the chain id with the chain id of the same chain definition file at the git ref.
+// When the base name and EVM chain id are unchanged, the revision must not decrease.
+// Chain definition files which do not exist at the git ref are new chains, and those which can not be parsed there
+// have no previous chain id, nothing to compare in both cases.
+func isValidChainIdRevisionBump(repoDir string, gitRef string, chainDefinitionFile string, cd valtypes.ChainDefinition) bool {
+	previous, found, err := readChainDefinitionAtGitRef(repoDir, gitRef, chainDefinitionFile)
+	if err != nil {
+		utils.PrintlnStdErr("ERR: Failed to read chain definition at git ref", gitRef+":", err)
+		return false
+	}
+	if !found || previous.ChainId == cd.ChainId {
+		return true
+	}
+
+	previousChainId := valtypes.ParseChainId(previous.ChainId)
+	currentChainId := valtypes.ParseChainId(cd.ChainId)
+	if previousChainId.Name != currentChainId.Name || previousChainId.EvmChainId != currentChainId.EvmChainId {
+		// not a revision bump
+		return true
+	}
+
+	previousRevision, err := previousChainId.RevisionNumber()
+	if err != nil {
+		// previous chain id was invalid, nothing to compare
+		return true
+	}
+	currentRevision, err := currentChainId.RevisionNumber()
+	if err != nil {
+		// reported by isValidChainId
+		return true
+	}
+
+	if currentRevision < previousRevision {
+		utils.PrintfStdErr("ERR: Revision of chain id must only increase, was %s at %s, now %s\n", previous.ChainId, gitRef, cd.ChainId)
+		return false
+	}
+
+	return true
+}
+
+// readChainDefinitionAtGitRef reads the chain definition file at the git ref,
+// found is false if the file does not exist at the ref or can not be parsed there, nothing to compare in both cases.
+func readChainDefinitionAtGitRef(repoDir string, gitRef string, chainDefinitionFile string) (cd valtypes.ChainDefinition, found bool, err error) {
+	relPath, err := filepath.Rel(repoDir, chainDefinitionFile)
+	if err != nil {
+		return
+	}
+
+	// "./" makes the path relative to the working directory of git, which is the registry root
+	object := gitRef + ":./" + filepath.ToSlash(relPath)
+
+	// exit code tells whether the object exists, independent of the language of git messages
+	if err = exec.Command("git", "-C", repoDir, "cat-file", "-e", object).Run(); err != nil {
+		if _, isExitErr := err.(*exec.ExitError); isExitErr {
+			return cd, false, nil
+		}
+		return cd, false, err
+	}
+
+	cmd := exec.Command("git", "-C", repoDir, "cat-file", "blob", object)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return cd, false, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	if json.Unmarshal(stdout.Bytes(), &cd) != nil {
+		return valtypes.ChainDefinition{}, false, nil
+	}
+
+	return cd, true, nil
+}
@@ -0,0 +1,56 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func Test_isValidChainIdRevisionBump(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	repoDir := t.TempDir()
+	git := func(args ...string) {
+		output, err := exec.Command("git", append([]string{"-C", repoDir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+	writeFile := func(relPath string, content string) string {
+		filePath := filepath.Join(repoDir, relPath)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o644))
+		return filePath
+	}
+
+	git("init", "--quiet")
+	existingFile := writeFile("mainnet/rollapp1/rollapp1.json", `{"chainId": "rollapp_100-2"}`)
+	malformedFile := writeFile("mainnet/rollapp2/rollapp2.json", `{"chainId": `)
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "base")
+
+	newFile := writeFile("mainnet/rollapp3/rollapp3.json", `{"chainId": "rollapp3_300-1"}`)
+
+	tests := []struct {
+		name                string
+		chainDefinitionFile string
+		chainId             string
+		want                bool
+	}{
+		{name: "unchanged", chainDefinitionFile: existingFile, chainId: "rollapp_100-2", want: true},
+		{name: "revision increased", chainDefinitionFile: existingFile, chainId: "rollapp_100-3", want: true},
+		{name: "revision decreased", chainDefinitionFile: existingFile, chainId: "rollapp_100-1", want: false},
+		{name: "not a revision bump", chainDefinitionFile: existingFile, chainId: "rollapp_200-1", want: true},
+		{name: "not exist at git ref", chainDefinitionFile: newFile, chainId: "rollapp3_300-1", want: true},
+		{name: "can not be parsed at git ref", chainDefinitionFile: malformedFile, chainId: "rollapp2_200-1", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cd := valtypes.ChainDefinition{ChainId: tt.chainId}
+			require.Equal(t, tt.want, isValidChainIdRevisionBump(repoDir, "HEAD", tt.chainDefinitionFile, cd))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"sort"
+	"strings"
+)
+
+// chainTypeAllowLists returns the lists of allowed chain types, in the order they are consulted:
+// built-in chain types, chain types added by the rule config and chain types provided via flag.
+func chainTypeAllowLists(options validateOptions) []valtypes.ChainTypeAllowList {
+	builtInChainTypes := valtypes.BuiltInChainTypes()
+
+	isBuiltIn := make(map[valtypes.ChainType]bool)
+	for _, chainType := range builtInChainTypes {
+		isBuiltIn[chainType] = true
+	}
+
+	var ruleConfigChainTypes []valtypes.ChainType
+	for chainType := range options.ruleConfig.ChainTypes {
+		if !isBuiltIn[chainType] {
+			ruleConfigChainTypes = append(ruleConfigChainTypes, chainType)
+		}
+	}
+	sort.Slice(ruleConfigChainTypes, func(i, j int) bool {
+		return ruleConfigChainTypes[i] < ruleConfigChainTypes[j]
+	})
+
+	var flagChainTypes []valtypes.ChainType
+	for _, chainType := range options.additionalChainTypesAllowed {
+		if chainType = strings.TrimSpace(chainType); chainType != "" {
+			flagChainTypes = append(flagChainTypes, valtypes.ChainType(chainType))
+		}
+	}
+
+	return []valtypes.ChainTypeAllowList{
+		{Source: "built-in chain types", ChainTypes: builtInChainTypes},
+		{Source: "chainTypes of rule config", ChainTypes: ruleConfigChainTypes},
+		{Source: fmt.Sprintf("--%s flag", flagAdditionChainTypesAllowed), ChainTypes: flagChainTypes},
+	}
+}
+
+// isValidChainType resolves the chain type against the allow lists.
+// When not recognized, every consulted allow list is reported.
+func isValidChainType(chainType string, allowLists []valtypes.ChainTypeAllowList, caseInsensitive bool) (resolved valtypes.ChainType, valid bool) {
+	if chainType == "" {
+		utils.PrintlnStdErr("ERR: Chain type is required")
+		return "", false
+	}
+
+	resolved, _, found := valtypes.ResolveChainType(chainType, allowLists, caseInsensitive)
+	if found {
+		return resolved, true
+	}
+
+	utils.PrintlnStdErr("ERR: Not recognized chain type:", chainType, fmt.Sprintf("(consider provide into --%s flag)", flagAdditionChainTypesAllowed))
+	for _, allowList := range allowLists {
+		utils.PrintfStdErr("ERR: Consulted %s: %v\n", allowList.Source, allowList.ChainTypes)
+	}
+	if !caseInsensitive {
+		if similar, allowList, found := valtypes.ResolveChainType(chainType, allowLists, true); found {
+			utils.PrintlnStdErr("ERR: Chain type matching is case-sensitive, did you mean", similar, "of", allowList.Source+"?")
+		}
+	}
+	return "", false
+}
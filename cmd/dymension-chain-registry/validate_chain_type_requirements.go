@@ -0,0 +1,45 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+)
+
+// chainTypeRequirementIssues checks the chain definition against the field requirements of its chain type.
+// Chain types without entry in the requirements table, e.g. provided via --addition-chain-types-allowed,
+// are checked against the default requirements of unknown chain types.
+// Nothing is checked when the chain type could not be resolved, it was reported by isValidChainType.
+func chainTypeRequirementIssues(cd valtypes.ChainDefinition, chainType valtypes.ChainType, chainTypes map[valtypes.ChainType]valtypes.ChainTypeRequirements) (issues []string) {
+	if chainType == "" {
+		return nil
+	}
+
+	for _, violation := range requirementsOfChainType(chainType, chainTypes).Check(cd) {
+		var issue string
+		if violation.Missing {
+			issue = fmt.Sprintf("Field %s is required for %s chains", violation.Field, chainType)
+		} else {
+			issue = fmt.Sprintf("Field %s is not allowed for %s chains", violation.Field, chainType)
+		}
+		if violation.Currency != "" {
+			issue += fmt.Sprintf(", currency %s", violation.Currency)
+		}
+		issues = append(issues, issue)
+	}
+	return
+}
+
+// isDAForbiddenByChainType returns true if the chain type forbids the DA field. DA-specific fields of such chains
+// are forbidden too and reported by chainTypeRequirementIssues, they must not be reported again by isValidDAFields.
+func isDAForbiddenByChainType(chainType valtypes.ChainType, chainTypes map[valtypes.ChainType]valtypes.ChainTypeRequirements) bool {
+	return chainType != "" && requirementsOfChainType(chainType, chainTypes).IsForbidden("da")
+}
+
+// requirementsOfChainType returns the requirements of the chain type from the requirements table,
+// or the default requirements of unknown chain types if the table has no entry.
+func requirementsOfChainType(chainType valtypes.ChainType, chainTypes map[valtypes.ChainType]valtypes.ChainTypeRequirements) valtypes.ChainTypeRequirements {
+	if requirements, found := chainTypes[chainType]; found {
+		return requirements
+	}
+	return valtypes.DefaultRequirementsOfUnknownChainType()
+}
@@ -0,0 +1,102 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_chainTypeRequirementIssues_UnknownChainType(t *testing.T) {
+	chainTypes := valtypes.DefaultChainTypeRequirements()
+	const unknownChainType valtypes.ChainType = "Custom"
+
+	t.Run("baseline rules apply", func(t *testing.T) {
+		cd := valtypes.ChainDefinition{
+			Type:     string(unknownChainType),
+			DA:       "Celestia",
+			Goldberg: true,
+		}
+		require.Equal(t, []string{
+			"Field currencies is required for Custom chains",
+			"Field coinType is required for Custom chains",
+			"Field da is not allowed for Custom chains",
+			"Field goldberg is not allowed for Custom chains",
+		}, chainTypeRequirementIssues(cd, unknownChainType, chainTypes))
+	})
+
+	t.Run("satisfied", func(t *testing.T) {
+		cd := valtypes.ChainDefinition{
+			Type:       string(unknownChainType),
+			CoinType:   118,
+			Currencies: []valtypes.CurrencyChainDefinition{{DisplayDenom: "FOO"}},
+		}
+		require.Empty(t, chainTypeRequirementIssues(cd, unknownChainType, chainTypes))
+	})
+
+	t.Run("chain type not resolved", func(t *testing.T) {
+		require.Empty(t, chainTypeRequirementIssues(valtypes.ChainDefinition{Type: "Bad", DA: "Celestia"}, "", chainTypes))
+	})
+
+	t.Run("entry of the rule config takes precedence", func(t *testing.T) {
+		withEntry := valtypes.DefaultChainTypeRequirements()
+		withEntry[unknownChainType] = valtypes.ChainTypeRequirements{}
+		require.Empty(t, chainTypeRequirementIssues(valtypes.ChainDefinition{Type: string(unknownChainType)}, unknownChainType, withEntry))
+	})
+}
+
+func Test_isDAForbiddenByChainType(t *testing.T) {
+	chainTypes := valtypes.DefaultChainTypeRequirements()
+
+	require.False(t, isDAForbiddenByChainType(valtypes.ChainTypeRollApp, chainTypes))
+	require.True(t, isDAForbiddenByChainType(valtypes.ChainTypeRegular, chainTypes))
+	require.True(t, isDAForbiddenByChainType("Custom", chainTypes), "unknown chain types forbid DA by default")
+	require.False(t, isDAForbiddenByChainType("", chainTypes), "chain type not resolved")
+
+	// DA-specific field of a chain type which forbids DA is reported once, by the chain type requirements
+	cd := valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRegular), AvailAddress: "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"}
+	require.Equal(t, []string{
+		"Field currencies is required for Regular chains",
+		"Field coinType is required for Regular chains",
+		"Field availAddress is not allowed for Regular chains",
+	}, chainTypeRequirementIssues(cd, valtypes.ChainTypeRegular, chainTypes))
+	require.True(t, isDAForbiddenByChainType(valtypes.ChainTypeRegular, chainTypes), "isValidDAFields is skipped")
+}
+
+func Test_isValidDAFields(t *testing.T) {
+	tests := []struct {
+		name string
+		cd   valtypes.ChainDefinition
+		want bool
+	}{
+		{
+			name: "RollApp with Avail fields and Avail DA",
+			cd:   valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), DA: "Avail", Goldberg: true},
+			want: true,
+		},
+		{
+			name: "RollApp with Avail fields and Celestia DA",
+			cd:   valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), DA: "Celestia", Goldberg: true},
+			want: false,
+		},
+		{
+			name: "non-RollApp without DA fields",
+			cd:   valtypes.ChainDefinition{Type: "Custom"},
+			want: true,
+		},
+		{
+			name: "non-RollApp with Avail fields and Celestia DA",
+			cd:   valtypes.ChainDefinition{Type: "Custom", DA: "Celestia", Goldberg: true},
+			want: false,
+		},
+		{
+			name: "non-RollApp with Avail fields without DA",
+			cd:   valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRegular), Goldberg: true},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isValidDAFields(tt.cd))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/wellknown"
+	"regexp"
+	"strings"
+)
+
+const (
+	// cosmosCoinType is the coin type of Cosmos Hub, used by most Cosmos-SDK chains for secp256k1 keys
+	cosmosCoinType = 118
+	// evmCoinType is the coin type of Ethereum, used by EVM-compatible chains for eth_secp256k1 keys
+	evmCoinType = 60
+)
+
+// coinTypeRegistryWarning returns a warning when the coin type is not found in the embedded curated subset of
+// SLIP-44 coin types, so it could not be verified, or is registered to a different network than the chain.
+// Coin types 118 and 60 are accepted for any chain since they are the common choices of Cosmos-SDK and EVM chains,
+// using them means wallets derive the same addresses as for Cosmos Hub and Ethereum respectively.
+func coinTypeRegistryWarning(cd valtypes.ChainDefinition) string {
+	if cd.CoinType <= 0 || cd.CoinType == cosmosCoinType || cd.CoinType == evmCoinType {
+		return ""
+	}
+
+	registered, found := wellknown.LookupSlip44CoinType(cd.CoinType)
+	if !found {
+		return fmt.Sprintf(
+			"Coin type %d is not in the embedded curated subset of SLIP-44 coin types, make sure it is registered in SLIP-44 to this chain, otherwise use %d for Cosmos-SDK chains or %d for EVM chains",
+			cd.CoinType, cosmosCoinType, evmCoinType,
+		)
+	}
+
+	if isChainOfSlip44Registration(cd, registered) {
+		return ""
+	}
+
+	desc := registered.Name
+	if registered.Symbol != "" {
+		desc += " (" + registered.Symbol + ")"
+	}
+	return fmt.Sprintf(
+		"Coin type %d is registered in SLIP-44 to %s which does not look like this chain, use %d for Cosmos-SDK chains or %d for EVM chains unless the chain has its own registered coin type",
+		cd.CoinType, desc, cosmosCoinType, evmCoinType,
+	)
+}
+
+// isChainOfSlip44Registration returns true if the chain name, chain id or ticker of any currency
+// matches the network which the coin type is registered to.
+func isChainOfSlip44Registration(cd valtypes.ChainDefinition, registered wellknown.Slip44CoinType) bool {
+	normalize := func(s string) string {
+		return regexp.MustCompile(`[^a-z\d]`).ReplaceAllString(strings.ToLower(s), "")
+	}
+
+	registeredName := normalize(registered.Name)
+	if registeredName != "" && strings.Contains(normalize(cd.ChainName), registeredName) {
+		return true
+	}
+
+	if registered.Symbol == "" {
+		return false
+	}
+	if strings.EqualFold(cd.ChainIdPrefix(), registered.Symbol) {
+		return true
+	}
+	for _, currency := range cd.Currencies {
+		if strings.EqualFold(currency.DisplayDenom, registered.Symbol) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"strings"
+)
+
+// isSafeUnicodeText checks the user-facing text does not mix letters of different scripts,
+// a common way to spoof well-known names, and is NFC normalized.
+func isSafeUnicodeText(kind string, text string) bool {
+	if !utils.IsNFC(text) {
+		utils.PrintlnStdErr("ERR:", kind, "is not NFC normalized, use precomposed characters:", text)
+		return false
+	}
+
+	scripts := utils.Scripts(text)
+	if len(scripts) > 1 && !isAllowedScriptsMix(scripts) {
+		utils.PrintlnStdErr("ERR:", kind, "mixes letters of multiple scripts", strings.Join(scripts, ", ")+":", text)
+		return false
+	}
+
+	return true
+}
+
+// isAllowedScriptsMix accepts scripts which are naturally mixed in CJK writing, along with Latin,
+// as the "Highly Restrictive" level of UTS #39: Japanese, Chinese with Bopomofo and Korean.
+func isAllowedScriptsMix(scripts []string) bool {
+	for _, allowedMix := range [][]string{
+		{"Latin", "Han", "Hiragana", "Katakana"},
+		{"Latin", "Han", "Bopomofo"},
+		{"Latin", "Han", "Hangul"},
+	} {
+		allowed := true
+		for _, script := range scripts {
+			var found bool
+			for _, allowedScript := range allowedMix {
+				if script == allowedScript {
+					found = true
+					break
+				}
+			}
+			if !found {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// findConfusableNames reports chain names and display denoms which are visually confusable with,
+// but not the same as, those of other chains of the group, e.g. "DYM" and "DYΜ" (with Greek Mu).
+func findConfusableNames(index *registryIndex) (issues []registryIssue) {
+	type namedEntry struct {
+		chain *indexedChain
+		name  string
+	}
+
+	findConfusable := func(kind string, entries []namedEntry) {
+		entriesBySkeleton := make(map[string][]namedEntry)
+		for _, entry := range entries {
+			skeleton := utils.ConfusableSkeleton(entry.name)
+			for _, existing := range entriesBySkeleton[skeleton] {
+				if existing.chain == entry.chain || existing.name == entry.name {
+					continue
+				}
+				issues = append(issues, registryIssue{
+					chain:   entry.chain,
+					message: []any{fmt.Sprintf("%s %q is confusable with %s %q of chain %s", kind, entry.name, strings.ToLower(kind), existing.name, existing.chain.dirName)},
+				})
+				break
+			}
+			entriesBySkeleton[skeleton] = append(entriesBySkeleton[skeleton], entry)
+		}
+	}
+
+	var chainNames, displayDenoms []namedEntry
+	for _, chain := range index.chains {
+		chainNames = append(chainNames, namedEntry{chain: chain, name: chain.cd.ChainName})
+		for _, currency := range chain.cd.Currencies {
+			displayDenoms = append(displayDenoms, namedEntry{chain: chain, name: currency.DisplayDenom})
+		}
+	}
+
+	findConfusable("Chain name", chainNames)
+	findConfusable("Display denom", displayDenoms)
+
+	return
+}
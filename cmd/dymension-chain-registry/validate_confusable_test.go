@@ -0,0 +1,33 @@
+package dymension_chain_registry
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_isSafeUnicodeText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "Latin", text: "Dymension Hub", want: true},
+		{name: "Han", text: "测试链", want: true},
+		{name: "Latin and Han", text: "DYM 测试", want: true},
+		{name: "Latin, Han, Hiragana and Katakana", text: "Dymension のテスト版", want: true},
+		{name: "Latin and Katakana", text: "Dymension テスト", want: true},
+		{name: "Latin, Han and Bopomofo", text: "DYM 注音ㄅㄆㄇ", want: true},
+		{name: "Latin and Hangul", text: "Dymension 테스트", want: true},
+		{name: "Latin, Han and Hangul", text: "DYM 韓國 테스트", want: true},
+		{name: "Latin and Cyrillic", text: "Dymеnsion", want: false},
+		{name: "Latin and Greek", text: "DYΜ", want: false},
+		{name: "Hangul and Katakana", text: "테스트 テスト", want: false},
+		{name: "Hangul and Bopomofo", text: "테스트 ㄅㄆㄇ", want: false},
+		{name: "not NFC normalized", text: "Cafe\u0301", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isSafeUnicodeText("Chain name", tt.text))
+		})
+	}
+}
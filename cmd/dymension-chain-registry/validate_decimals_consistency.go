@@ -0,0 +1,30 @@
+package dymension_chain_registry
+
+import "fmt"
+
+// findDecimalsMismatches reports bridged currencies which decimals is different from the decimals
+// of the same asset defined on its origin chain.
+func findDecimalsMismatches(index *registryIndex) (issues []registryIssue) {
+	for _, group := range index.assetGroups() {
+		origin, found := group.origin()
+		if !found {
+			continue
+		}
+		for _, member := range group.members {
+			if member.chain == origin.chain {
+				continue
+			}
+			if member.currency.Decimals != origin.currency.Decimals {
+				issues = append(issues, registryIssue{
+					chain: member.chain,
+					message: []any{fmt.Sprintf(
+						"Decimals mismatch, currency %s (%s) has decimals %d but the same asset %s on its origin chain %s has decimals %d",
+						member.currency.DisplayDenom, member.currency.BaseDenom, member.currency.Decimals,
+						origin.currency.BaseDenom, origin.chain.dirName, origin.currency.Decimals,
+					)},
+				})
+			}
+		}
+	}
+	return
+}
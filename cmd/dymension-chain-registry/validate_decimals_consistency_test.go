@@ -0,0 +1,113 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_findDecimalsMismatches(t *testing.T) {
+	const (
+		usdcContract = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+		usdcMint     = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+	)
+
+	type chain struct {
+		dirName string
+		cd      valtypes.ChainDefinition
+	}
+
+	tests := []struct {
+		name       string
+		chains     []chain
+		wantChains []string
+		wantMsg    string
+	}{
+		{
+			name: "bridged from EVM origin",
+			chains: []chain{
+				{dirName: "ethereum", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeEVM), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC", BaseDenom: usdcContract, Decimals: 6, Type: "regular"},
+				}}},
+				{dirName: "rollapp1", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC", BaseDenom: "hyperlane/0x01", BridgeDenom: usdcContract, Decimals: 18, Type: "regular"},
+				}}},
+			},
+			wantChains: []string{"rollapp1"},
+			wantMsg:    "currency USDC (hyperlane/0x01) has decimals 18 but the same asset " + usdcContract + " on its origin chain ethereum has decimals 6",
+		},
+		{
+			name: "bridged from Solana origin",
+			chains: []chain{
+				{dirName: "solana", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeSolana), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC", BaseDenom: usdcMint, Decimals: 6, Type: "regular"},
+				}}},
+				{dirName: "rollapp1", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC.sol", BaseDenom: "hyperlane/0x02", BridgeDenom: usdcMint, Decimals: 9, Type: "regular"},
+				}}},
+			},
+			wantChains: []string{"rollapp1"},
+			wantMsg:    "currency USDC.sol (hyperlane/0x02) has decimals 9 but the same asset " + usdcMint + " on its origin chain solana has decimals 6",
+		},
+		{
+			name: "bridged with the same decimals",
+			chains: []chain{
+				{dirName: "ethereum", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeEVM), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC", BaseDenom: usdcContract, Decimals: 6, Type: "regular"},
+				}}},
+				{dirName: "rollapp1", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC", BaseDenom: "hyperlane/0x01", BridgeDenom: usdcContract, Decimals: 6, Type: "regular"},
+				}}},
+			},
+		},
+		{
+			name: "hub currency and its IBC voucher on RollApp",
+			chains: []chain{
+				{dirName: "dymension", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeHub), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "DYM", BaseDenom: "adym", Decimals: 18, Type: "main"},
+				}}},
+				{dirName: "rollapp1", cd: valtypes.ChainDefinition{
+					Type: string(valtypes.ChainTypeRollApp),
+					IBC:  &valtypes.IbcChainDefinition{Channel: "channel-0"},
+					Currencies: []valtypes.CurrencyChainDefinition{
+						{DisplayDenom: "RA", BaseDenom: "ara", Decimals: 18, Type: "main"},
+						{DisplayDenom: "DYM", BaseDenom: utils.IbcDenom("transfer", "channel-0", "adym"), Decimals: 6, Type: "regular"},
+					},
+				}},
+			},
+			wantChains: []string{"rollapp1"},
+			wantMsg:    "currency DYM (" + utils.IbcDenom("transfer", "channel-0", "adym") + ") has decimals 6 but the same asset adym on its origin chain dymension has decimals 18",
+		},
+		{
+			name: "ambiguous origin with multiple non-IBC members",
+			chains: []chain{
+				{dirName: "rollapp1", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC", BaseDenom: "hyperlane/0x01", BridgeDenom: usdcContract, Decimals: 6, Type: "regular"},
+				}}},
+				{dirName: "rollapp2", cd: valtypes.ChainDefinition{Type: string(valtypes.ChainTypeRollApp), Currencies: []valtypes.CurrencyChainDefinition{
+					{DisplayDenom: "USDC", BaseDenom: "hyperlane/0x02", BridgeDenom: usdcContract, Decimals: 18, Type: "regular"},
+				}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := &registryIndex{}
+			for _, c := range tt.chains {
+				index.add(c.dirName, "", "", c.cd)
+			}
+
+			issues := findDecimalsMismatches(index)
+
+			var gotChains []string
+			for _, issue := range issues {
+				gotChains = append(gotChains, issue.chain.dirName)
+			}
+			require.Equal(t, tt.wantChains, gotChains)
+			if tt.wantMsg != "" {
+				require.Contains(t, issues[0].message[0], tt.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"regexp"
+)
+
+// isValidStructuredDenom validates each part of the well-known structured forms of denom:
+// ibc/<HASH>, factory/<creator>/<sub-denom>, erc20/0x<address> and gamm/pool/<id>.
+func isValidStructuredDenom(denom string, cd valtypes.ChainDefinition) bool {
+	parsed := valtypes.ParseDenom(denom)
+
+	switch parsed.Kind {
+	case valtypes.DenomKindIbc:
+		if !regexp.MustCompile(`^[A-F\d]{64}$`).MatchString(parsed.IbcHash) {
+			//goland:noinspection SpellCheckingInspection
+			utils.PrintlnStdErr("ERR: IBC denom must match format ibc/32BYTESHASH, hash must be uppercase hex:", denom)
+			return false
+		}
+	case valtypes.DenomKindFactory:
+		if parsed.FactoryCreator == "" || parsed.FactorySubDenom == "" {
+			utils.PrintlnStdErr("ERR: Token factory denom must match format factory/<creator>/<sub-denom>:", denom)
+			return false
+		}
+		hrp, _, err := utils.DecodeBech32(parsed.FactoryCreator)
+		if err != nil {
+			utils.PrintlnStdErr("ERR: Token factory denom creator is not a valid bech32 address:", parsed.FactoryCreator, err)
+			return false
+		}
+		if cd.Bech32Prefix != "" && hrp != cd.Bech32Prefix {
+			utils.PrintlnStdErr("ERR: Token factory denom creator must use bech32 prefix of the chain", cd.Bech32Prefix+", got:", hrp)
+			return false
+		}
+		if len(parsed.FactorySubDenom) > 44 {
+			utils.PrintlnStdErr("ERR: Token factory sub-denom must not exceed 44 characters:", parsed.FactorySubDenom)
+			return false
+		}
+		if !regexp.MustCompile(`^[a-zA-Z\d._-]+$`).MatchString(parsed.FactorySubDenom) {
+			utils.PrintlnStdErr("ERR: Token factory sub-denom must be alphanumeric, dot, underscore, or dash:", parsed.FactorySubDenom)
+			return false
+		}
+	case valtypes.DenomKindErc20:
+		if !regexp.MustCompile(`^0x[a-fA-F\d]{40}$`).MatchString(parsed.ContractAddress) {
+			utils.PrintlnStdErr("ERR: ERC-20 denom must match format erc20/0x<40 hex characters>:", denom)
+			return false
+		}
+	case valtypes.DenomKindGammPool:
+		if !regexp.MustCompile(`^[1-9]\d*$`).MatchString(parsed.PoolId) {
+			utils.PrintlnStdErr("ERR: Gamm pool denom must match format gamm/pool/<positive number without leading zeros>:", denom)
+			return false
+		}
+	}
+
+	return true
+}
+
+// nativeDenomDecimalsWarning returns a warning when the unit prefix of the native base denom ("a" or "u")
+// implies decimals which is different from the decimals of the currency.
+// The prefix is only a convention, e.g. "uni" is not micro-ni, so this is not an error.
+func nativeDenomDecimalsWarning(currency valtypes.CurrencyChainDefinition) string {
+	parsed := valtypes.ParseDenom(currency.BaseDenom)
+	expectedDecimals, found := parsed.ExpectedDecimals()
+	if !found || expectedDecimals == currency.Decimals {
+		return ""
+	}
+	return fmt.Sprintf("Base denom %s has unit prefix '%s' which implies decimals %d, but decimals is %d", currency.BaseDenom, parsed.UnitPrefix, expectedDecimals, currency.Decimals)
+}
@@ -0,0 +1,38 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+)
+
+// isValidChainDirectoryName checks the name of the chain directory relates to the chain definition inside it.
+func isValidChainDirectoryName(dirName string, cd valtypes.ChainDefinition, rules valtypes.DirectoryNameRuleConfig) bool {
+	if len(rules.MatchAnyOf) < 1 {
+		return true
+	}
+
+	var expectedNames []any
+	for _, rule := range rules.MatchAnyOf {
+		var expectedName string
+		switch rule {
+		case valtypes.DirectoryNameRuleChainId:
+			expectedName = cd.ChainId
+		case valtypes.DirectoryNameRuleChainIdPrefix:
+			expectedName = cd.ChainIdPrefix()
+		case valtypes.DirectoryNameRuleChainNameSlug:
+			expectedName = cd.ChainNameSlug()
+		default:
+			utils.PrintlnStdErr("ERR: Not recognized directory name rule:", rule)
+			return false
+		}
+
+		if expectedName != "" && dirName == expectedName {
+			return true
+		}
+
+		expectedNames = append(expectedNames, "'"+expectedName+"'", "("+string(rule)+")")
+	}
+
+	utils.PrintlnStdErr(append([]any{"ERR: Directory name must match one of:"}, expectedNames...)...)
+	return false
+}
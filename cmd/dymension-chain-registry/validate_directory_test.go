@@ -0,0 +1,80 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_isValidChainDirectoryName(t *testing.T) {
+	cd := valtypes.ChainDefinition{
+		ChainId:   "dymension_1100-1",
+		ChainName: "Dymension Hub",
+	}
+	defaultRules := valtypes.DefaultRuleConfig().DirectoryName
+
+	tests := []struct {
+		name    string
+		dirName string
+		rules   valtypes.DirectoryNameRuleConfig
+		want    bool
+	}{
+		{
+			name:    "chain id",
+			dirName: "dymension_1100-1",
+			rules:   defaultRules,
+			want:    true,
+		},
+		{
+			name:    "chain id prefix",
+			dirName: "dymension",
+			rules:   defaultRules,
+			want:    true,
+		},
+		{
+			name:    "chain name slug",
+			dirName: "dymension-hub",
+			rules:   defaultRules,
+			want:    true,
+		},
+		{
+			name:    "unrelated",
+			dirName: "foo",
+			rules:   defaultRules,
+			want:    false,
+		},
+		{
+			name:    "letter case",
+			dirName: "Dymension",
+			rules:   defaultRules,
+			want:    false,
+		},
+		{
+			name:    "not matching the only rule",
+			dirName: "dymension",
+			rules: valtypes.DirectoryNameRuleConfig{
+				MatchAnyOf: []valtypes.DirectoryNameRule{valtypes.DirectoryNameRuleChainId},
+			},
+			want: false,
+		},
+		{
+			name:    "no rule disables the check",
+			dirName: "foo",
+			rules:   valtypes.DirectoryNameRuleConfig{},
+			want:    true,
+		},
+		{
+			name:    "unknown rule",
+			dirName: "foo",
+			rules: valtypes.DirectoryNameRuleConfig{
+				MatchAnyOf: []valtypes.DirectoryNameRule{"unknown"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isValidChainDirectoryName(tt.dirName, cd, tt.rules))
+		})
+	}
+}
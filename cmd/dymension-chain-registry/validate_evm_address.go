@@ -0,0 +1,55 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"strings"
+)
+
+// evmContractAddressOfDenom returns the EVM contract address carried by the denom:
+// the denom itself if it is a 0x address on EVM chains, or the address part of erc20/0x<address> denom.
+func evmContractAddressOfDenom(denom string, cd valtypes.ChainDefinition, chainType valtypes.ChainType) (address string, found bool) {
+	parsed := valtypes.ParseDenom(denom)
+	if parsed.Kind == valtypes.DenomKindErc20 {
+		// format of ERC-20 denom was reported by isValidStructuredDenom
+		return parsed.ContractAddress, utils.IsEvmAddressFormat(parsed.ContractAddress)
+	}
+	if (chainType == valtypes.ChainTypeEVM || cd.IsEvmRollApp()) && strings.HasPrefix(denom, "0x") {
+		return denom, true
+	}
+	return "", false
+}
+
+// isValidEvmContractAddress checks the length and the EIP-55 checksum of mixed-case EVM address.
+// Address which is all lowercase or all uppercase carries no checksum, it is reported by evmContractAddressChecksumWarning.
+func isValidEvmContractAddress(address string) bool {
+	if len(address) != 42 {
+		utils.PrintlnStdErr("ERR: EVM contract address must be 42 characters long (0x followed by 40 hex characters), got", len(address), "characters:", address)
+		return false
+	}
+	if !utils.IsEvmAddressFormat(address) {
+		utils.PrintlnStdErr("ERR: EVM contract address must be 0x followed by 40 hex characters:", address)
+		return false
+	}
+	if utils.IsEip55Checksummed(address) {
+		if expected := utils.ToEip55ChecksumAddress(address); expected != address {
+			utils.PrintlnStdErr("ERR: EVM contract address has invalid EIP-55 checksum, possibly typo:", address, "expected checksum form", expected)
+			return false
+		}
+	}
+	return true
+}
+
+// evmContractAddressChecksumWarning returns a warning when the EVM address does not carry EIP-55 checksum
+func evmContractAddressChecksumWarning(address string) string {
+	if utils.IsEip55Checksummed(address) {
+		return ""
+	}
+	checksummed := utils.ToEip55ChecksumAddress(address)
+	if checksummed == address {
+		// all-uppercase or all-lowercase form which happens to be the checksum form
+		return ""
+	}
+	return fmt.Sprintf("EVM contract address %s is not EIP-55 checksummed, use %s", address, checksummed)
+}
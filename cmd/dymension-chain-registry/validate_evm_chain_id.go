@@ -0,0 +1,66 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"github.com/bcdevtools/chain-registry-validation-tool/wellknown"
+	"math/big"
+)
+
+// isValidEvmChainId checks the EVM chain id, given in hex or decimal form, matches the EVM chain id part
+// of the Cosmos chain id when present, and is not used by a well-known EVM chain other than the chain itself,
+// unless the chain is known to reuse the EVM chain id of that well-known EVM chain.
+func isValidEvmChainId(cd valtypes.ChainDefinition) bool {
+	evmChainId, err := utils.ParseEvmChainId(cd.EVM.ChainId)
+	if err != nil {
+		utils.PrintlnStdErr("ERR:", err)
+		return false
+	}
+	if evmChainId.Cmp(utils.MaxSafeEvmChainId) > 0 {
+		utils.PrintlnStdErr("ERR: EVM chain id", evmChainId, "exceeds the maximum safe EVM chain id", utils.MaxSafeEvmChainId)
+		return false
+	}
+
+	if parsedChainId := valtypes.ParseChainId(cd.ChainId); parsedChainId.EvmChainId != "" {
+		chainIdFromCosmos, _ := new(big.Int).SetString(parsedChainId.EvmChainId, 10)
+		if chainIdFromCosmos.Cmp(evmChainId) != 0 {
+			utils.PrintfStdErr("ERR: EVM chain id %s must match with the chain id from cosmos chain id %s\n", evmChainId, chainIdFromCosmos)
+			return false
+		}
+	} else if cd.IsRollAppChain() {
+		utils.PrintlnStdErr("ERR: EVM RollApp chain id must have format <alphanumeric>_<number>-<number>")
+		return false
+	}
+
+	if wellKnownChain, found := wellknown.LookupEvmChain(evmChainId); found && !wellKnownChain.IsAllowedChainIdPrefix(cd.ChainIdPrefix()) {
+		utils.PrintlnStdErr("ERR: EVM chain id", evmChainId, "is already used by well-known EVM chain", wellKnownChain.Name)
+		return false
+	}
+
+	return true
+}
+
+// findEvmChainIdCollisions reports chains of the group which use the same EVM chain id,
+// hex and decimal forms of the same value are considered the same.
+func findEvmChainIdCollisions(index *registryIndex) (issues []registryIssue) {
+	chainOfEvmChainId := make(map[string]*indexedChain)
+	for _, chain := range index.chains {
+		if chain.cd.EVM == nil {
+			continue
+		}
+		evmChainId, err := utils.ParseEvmChainId(chain.cd.EVM.ChainId)
+		if err != nil {
+			continue
+		}
+		if existing, found := chainOfEvmChainId[evmChainId.String()]; found {
+			issues = append(issues, registryIssue{
+				chain:   chain,
+				message: []any{fmt.Sprintf("EVM chain id %s is also used by chain %s", evmChainId, existing.dirName)},
+			})
+			continue
+		}
+		chainOfEvmChainId[evmChainId.String()] = chain
+	}
+	return
+}
@@ -0,0 +1,39 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_isValidEvmChainId(t *testing.T) {
+	tests := []struct {
+		name       string
+		chainId    string
+		chainType  valtypes.ChainType
+		evmChainId string
+		want       bool
+	}{
+		{name: "RollApp", chainId: "rollappx_1234-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "1234", want: true},
+		{name: "RollApp in hex", chainId: "rollappx_1234-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "0x4d2", want: true},
+		{name: "mismatch with cosmos chain id", chainId: "rollappx_1234-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "1235", want: false},
+		{name: "RollApp without EVM chain id in cosmos chain id", chainId: "rollappx-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "1234", want: false},
+		{name: "owner of well-known EVM chain id", chainId: "dymension_1100-1", chainType: valtypes.ChainTypeHub, evmChainId: "1100", want: true},
+		{name: "owner of well-known EVM chain id without cosmos chain id", chainId: "ethereum", chainType: valtypes.ChainTypeEVM, evmChainId: "1", want: true},
+		{name: "well-known EVM chain id of other chain", chainId: "rollappx_8453-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "8453", want: false},
+		{name: "lookalike of well-known EVM chain", chainId: "basecamp_8453-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "8453", want: false},
+		{name: "lookalike of Dymension", chainId: "dymensionx_1100-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "1100", want: false},
+		{name: "Dymension testnet reusing EVM chain id of Gnosis", chainId: "froopyland_100-1", chainType: valtypes.ChainTypeHub, evmChainId: "100", want: true},
+		{name: "other chain using EVM chain id of Gnosis", chainId: "rollappx_100-1", chainType: valtypes.ChainTypeRollApp, evmChainId: "100", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cd := valtypes.ChainDefinition{
+				ChainId: tt.chainId,
+				Type:    string(tt.chainType),
+				EVM:     &valtypes.EvmChainDefinition{ChainId: tt.evmChainId},
+			}
+			require.Equal(t, tt.want, isValidEvmChainId(cd))
+		})
+	}
+}
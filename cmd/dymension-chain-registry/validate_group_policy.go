@@ -0,0 +1,28 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+)
+
+// groupPolicyIssues checks the chain definition against the policy of the group.
+// Missing recommended fields and unexpected collectData value are returned as warnings.
+func groupPolicyIssues(cd valtypes.ChainDefinition, target valtypes.ValidateTarget, policy valtypes.GroupPolicy) (issues, warnings []string) {
+	for _, field := range policy.ForbiddenFields {
+		if cd.HasField(field) {
+			issues = append(issues, fmt.Sprintf("Field %s is not allowed for group %s", field, target.String()))
+		}
+	}
+
+	for _, field := range policy.RecommendedFields {
+		if !cd.HasField(field) {
+			warnings = append(warnings, fmt.Sprintf("Field %s is recommended for group %s", field, target.String()))
+		}
+	}
+
+	if policy.CollectData != nil && cd.CollectData != *policy.CollectData {
+		warnings = append(warnings, fmt.Sprintf("Field collectData is expected to be %t for group %s", *policy.CollectData, target.String()))
+	}
+
+	return
+}
@@ -0,0 +1,224 @@
+package dymension_chain_registry
+
+import (
+	"bytes"
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+func isValidLogo(logo string, chainPath string, repoDir string, logoRules valtypes.LogoRuleConfig) bool {
+	if logo == "" {
+		return true
+	}
+
+	logoPath, realLogoPath, err := resolveLogoFile(logo, chainPath, repoDir, logoRules)
+	if err != nil {
+		utils.PrintlnStdErr("ERR:", err)
+		return false
+	}
+
+	ext := strings.ToLower(filepath.Ext(logoPath))
+	switch ext {
+	case ".png", ".jpg", ".jpeg", ".svg":
+	default:
+		utils.PrintlnStdErr("ERR: Logo file must be PNG, JPG, JPEG, or SVG:", logoPath)
+		return false
+	}
+
+	bz, err := readLogoFile(realLogoPath, logoRules.MaxBytes)
+	if err != nil {
+		utils.PrintlnStdErr("ERR: Failed to read logo file:", logoPath, err)
+		return false
+	}
+
+	if ext == ".svg" {
+		return isValidSvgLogoContent(bz, logoPath)
+	}
+
+	return isValidRasterLogoContent(bz, ext, logoPath, logoRules)
+}
+
+// resolveLogoFile resolves the logo path relative to the chain directory and ensures the logo file is placed
+// within the chain directory or the shared assets directory, does not point outside the repository by symlinks,
+// is a regular file and does not exceed the size limit.
+// The logo path is the path as declared, the real logo path is the path after resolving symlinks, to be read.
+func resolveLogoFile(logo string, chainPath string, repoDir string, logoRules valtypes.LogoRuleConfig) (logoPath, realLogoPath string, err error) {
+	if path.IsAbs(logo) || filepath.IsAbs(logo) || filepath.VolumeName(logo) != "" {
+		err = fmt.Errorf("logo path must be relative to the chain directory: %s", logo)
+		return
+	}
+
+	logoPath = filepath.Join(chainPath, filepath.FromSlash(logo))
+
+	if !isWithinDir(logoPath, chainPath) {
+		var sharedAssetsDir string
+		if logoRules.SharedAssetsDir != "" {
+			sharedAssetsDir = filepath.Join(repoDir, logoRules.SharedAssetsDir)
+		}
+		if sharedAssetsDir == "" || !isWithinDir(logoPath, sharedAssetsDir) {
+			if sharedAssetsDir == "" {
+				err = fmt.Errorf("logo file must be placed within the chain directory: %s resolved to %s", logo, logoPath)
+			} else {
+				err = fmt.Errorf("logo file must be placed within the chain directory or the shared assets directory %s: %s resolved to %s", sharedAssetsDir, logo, logoPath)
+			}
+			return
+		}
+	}
+
+	if _, err = os.Stat(logoPath); err != nil {
+		if os.IsNotExist(err) {
+			err = fmt.Errorf("logo file not found: %s", logoPath)
+			return
+		}
+		err = fmt.Errorf("failed to get stat of logo file %s: %w", logoPath, err)
+		return
+	}
+
+	realLogoPath, err = filepath.EvalSymlinks(logoPath)
+	if err != nil {
+		err = fmt.Errorf("failed to resolve symlinks of logo file %s: %w", logoPath, err)
+		return
+	}
+	realRepoDir, err := filepath.EvalSymlinks(repoDir)
+	if err != nil {
+		err = fmt.Errorf("failed to resolve symlinks of repository directory %s: %w", repoDir, err)
+		return
+	}
+	if !isWithinDir(realLogoPath, realRepoDir) {
+		err = fmt.Errorf("logo file is a symlink pointing outside the repository: %s resolved to %s", logoPath, realLogoPath)
+		return
+	}
+
+	info, err := os.Stat(realLogoPath)
+	if err != nil {
+		err = fmt.Errorf("failed to get stat of logo file %s: %w", realLogoPath, err)
+		return
+	}
+	if !info.Mode().IsRegular() {
+		err = fmt.Errorf("logo file must be a regular file: %s resolved to %s", logoPath, realLogoPath)
+		return
+	}
+	if logoRules.MaxBytes > 0 && info.Size() > logoRules.MaxBytes {
+		err = fmt.Errorf("logo file is too large, %d bytes, maximum allowed is %d bytes: %s", info.Size(), logoRules.MaxBytes, logoPath)
+		return
+	}
+
+	return
+}
+
+// readLogoFile reads the logo file, at most maxBytes when positive, so a file growing after its size was checked
+// can not exhaust the memory.
+func readLogoFile(logoPath string, maxBytes int64) ([]byte, error) {
+	file, err := os.Open(logoPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if maxBytes < 1 {
+		return io.ReadAll(file)
+	}
+
+	bz, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(bz)) > maxBytes {
+		return nil, fmt.Errorf("file is larger than %d bytes", maxBytes)
+	}
+	return bz, nil
+}
+
+// maxRasterLogoPixels is the maximum number of pixels of PNG/JPEG logo files to be fully decoded,
+// regardless of the dimension rules which can be disabled. A small file can declare huge dimensions (decompression bomb).
+const maxRasterLogoPixels = 4096 * 4096
+
+// isValidRasterLogoContent decodes PNG/JPEG logo content to ensure it matches the file extension,
+// is not corrupted and satisfies the dimension rules.
+func isValidRasterLogoContent(bz []byte, ext string, logoPath string, logoRules valtypes.LogoRuleConfig) bool {
+	expectedFormat := "png"
+	if ext == ".jpg" || ext == ".jpeg" {
+		expectedFormat = "jpeg"
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(bz))
+	if err != nil {
+		utils.PrintlnStdErr("ERR: Logo file is not a valid image:", logoPath, err)
+		return false
+	}
+	if format != expectedFormat {
+		utils.PrintlnStdErr("ERR: Logo file content is", strings.ToUpper(format), "but extension is", ext+":", logoPath)
+		return false
+	}
+
+	if logoRules.MinWidth > 0 && config.Width < logoRules.MinWidth {
+		utils.PrintfStdErr("ERR: Logo width %dpx is less than minimum %dpx: %s\n", config.Width, logoRules.MinWidth, logoPath)
+		return false
+	}
+	if logoRules.MinHeight > 0 && config.Height < logoRules.MinHeight {
+		utils.PrintfStdErr("ERR: Logo height %dpx is less than minimum %dpx: %s\n", config.Height, logoRules.MinHeight, logoPath)
+		return false
+	}
+	if logoRules.MaxWidth > 0 && config.Width > logoRules.MaxWidth {
+		utils.PrintfStdErr("ERR: Logo width %dpx exceeds maximum %dpx: %s\n", config.Width, logoRules.MaxWidth, logoPath)
+		return false
+	}
+	if logoRules.MaxHeight > 0 && config.Height > logoRules.MaxHeight {
+		utils.PrintfStdErr("ERR: Logo height %dpx exceeds maximum %dpx: %s\n", config.Height, logoRules.MaxHeight, logoPath)
+		return false
+	}
+	if logoRules.RequireSquare && config.Width != config.Height {
+		utils.PrintfStdErr("ERR: Logo must be square, got %dx%d: %s\n", config.Width, config.Height, logoPath)
+		return false
+	}
+
+	if !isWithinRasterLogoPixelLimit(config) {
+		utils.PrintfStdErr("ERR: Logo dimensions %dx%d exceed the limit of %d pixels: %s\n", config.Width, config.Height, maxRasterLogoPixels, logoPath)
+		return false
+	}
+
+	if _, err := decodeRasterLogo(bz); err != nil {
+		utils.PrintlnStdErr("ERR: Logo file is corrupted:", logoPath, err)
+		return false
+	}
+
+	return true
+}
+
+// decodeRasterLogo fully decodes PNG/JPEG logo content. Dimensions are checked against the image header
+// before the full decoding, so images declaring huge dimensions are rejected without allocating the pixel buffer.
+func decodeRasterLogo(bz []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(bz))
+	if err != nil {
+		return nil, err
+	}
+	if !isWithinRasterLogoPixelLimit(config) {
+		return nil, fmt.Errorf("image dimensions %dx%d exceed the limit of %d pixels", config.Width, config.Height, maxRasterLogoPixels)
+	}
+	img, _, err := image.Decode(bytes.NewReader(bz))
+	return img, err
+}
+
+func isWithinRasterLogoPixelLimit(config image.Config) bool {
+	return int64(config.Width)*int64(config.Height) <= maxRasterLogoPixels
+}
+
+// isWithinDir returns true if the target path is located inside the directory, both paths are compared lexically.
+func isWithinDir(targetPath string, dir string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(targetPath))
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
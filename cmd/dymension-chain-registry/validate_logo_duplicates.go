@@ -0,0 +1,163 @@
+package dymension_chain_registry
+
+import (
+	"crypto/sha256"
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"path/filepath"
+	"strings"
+)
+
+// logoUsage is a logo file referenced by a chain, or by a currency of the chain
+type logoUsage struct {
+	chain    *indexedChain
+	currency *valtypes.CurrencyChainDefinition // nil for chain logo
+	logoPath string
+
+	sha256Hash  [32]byte
+	hasAvgHash  bool
+	averageHash uint64
+}
+
+func (lu logoUsage) String() string {
+	if lu.currency == nil {
+		return fmt.Sprintf("logo of chain %s (%s)", lu.chain.dirName, lu.logoPath)
+	}
+	return fmt.Sprintf("logo of currency %s of chain %s (%s)", lu.currency.DisplayDenom, lu.chain.dirName, lu.logoPath)
+}
+
+// findDuplicatedLogos reports logo images which are byte-identical or perceptually near-identical
+// but used by unrelated chains or currencies, plus currencies which logo differs from the same asset's logo
+// on its origin chain.
+func findDuplicatedLogos(index *registryIndex, repoDir string, logoRules valtypes.LogoRuleConfig) (issues []registryIssue) {
+	if logoRules.DisableDuplicateDetection {
+		return nil
+	}
+
+	// assetGroupOfUsage returns the asset group of the currency, or of the main currency of the chain for chain logo,
+	// because chain logo is commonly the same image as the logo of the chain's native asset.
+	assetGroupOfUsage := func(usage logoUsage) *assetGroup {
+		if usage.currency != nil {
+			return index.assetGroupOf(usage.chain, usage.currency.BaseDenom)
+		}
+		if mainCurrency, found := usage.chain.mainCurrency(); found {
+			return index.assetGroupOf(usage.chain, mainCurrency.BaseDenom)
+		}
+		return nil
+	}
+
+	// isRelated returns true if both logo usages are expected to share the same logo
+	isRelated := func(a, b logoUsage) bool {
+		if a.chain == b.chain {
+			return true
+		}
+		assetGroupOfA := assetGroupOfUsage(a)
+		return assetGroupOfA != nil && assetGroupOfA == assetGroupOfUsage(b)
+	}
+
+	var usages []logoUsage
+	usageOfCurrency := make(map[*indexedChain]map[string]logoUsage)
+	for _, chain := range index.chains {
+		usageOfCurrency[chain] = make(map[string]logoUsage)
+		if usage, ok := loadLogoUsage(chain, nil, chain.cd.Logo, repoDir, logoRules); ok {
+			usages = append(usages, usage)
+		}
+		for i := range chain.cd.Currencies {
+			currency := &chain.cd.Currencies[i]
+			if usage, ok := loadLogoUsage(chain, currency, currency.Logo, repoDir, logoRules); ok {
+				usages = append(usages, usage)
+				usageOfCurrency[chain][currency.BaseDenom] = usage
+			}
+		}
+	}
+
+	isSameImage := func(a, b logoUsage) (same bool, desc string) {
+		if a.sha256Hash == b.sha256Hash {
+			return true, "byte-identical"
+		}
+		if a.hasAvgHash && b.hasAvgHash && !logoRules.DisableNearDuplicateDetection {
+			if distance := utils.HammingDistance(a.averageHash, b.averageHash); distance <= logoRules.NearDuplicateMaxDistance {
+				return true, fmt.Sprintf("near-identical (perceptual hash distance %d)", distance)
+			}
+		}
+		return false, ""
+	}
+
+	for i, usage := range usages {
+		for _, previousUsage := range usages[:i] {
+			if isRelated(usage, previousUsage) {
+				continue
+			}
+			if same, desc := isSameImage(usage, previousUsage); same {
+				issues = append(issues, registryIssue{
+					chain:   usage.chain,
+					message: []any{"The", usage.String(), "is", desc, "to the", previousUsage.String(), "which is unrelated"},
+				})
+				break
+			}
+		}
+	}
+
+	for _, group := range index.assetGroups() {
+		origin, found := group.origin()
+		if !found {
+			continue
+		}
+		originUsage, found := usageOfCurrency[origin.chain][origin.currency.BaseDenom]
+		if !found {
+			continue
+		}
+		for _, member := range group.members {
+			if member.chain == origin.chain {
+				continue
+			}
+			memberUsage, found := usageOfCurrency[member.chain][member.currency.BaseDenom]
+			if !found {
+				continue
+			}
+			if same, _ := isSameImage(memberUsage, originUsage); !same {
+				issues = append(issues, registryIssue{
+					chain:   member.chain,
+					message: []any{"The", memberUsage.String(), "differs from the logo of the same asset on its origin chain,", originUsage.String()},
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// loadLogoUsage reads the logo file and computes its hashes. The logo file is resolved and read the same way
+// as by isValidLogo, logo files which are rejected there are ignored here, they were reported by isValidLogo.
+func loadLogoUsage(chain *indexedChain, currency *valtypes.CurrencyChainDefinition, logo string, repoDir string, logoRules valtypes.LogoRuleConfig) (logoUsage, bool) {
+	if logo == "" {
+		return logoUsage{}, false
+	}
+
+	logoPath, realLogoPath, err := resolveLogoFile(logo, chain.chainPath, repoDir, logoRules)
+	if err != nil {
+		return logoUsage{}, false
+	}
+	bz, err := readLogoFile(realLogoPath, logoRules.MaxBytes)
+	if err != nil {
+		return logoUsage{}, false
+	}
+
+	usage := logoUsage{
+		chain:      chain,
+		currency:   currency,
+		logoPath:   logoPath,
+		sha256Hash: sha256.Sum256(bz),
+	}
+
+	switch strings.ToLower(filepath.Ext(logoPath)) {
+	case ".png", ".jpg", ".jpeg":
+		if img, err := decodeRasterLogo(bz); err == nil {
+			usage.hasAvgHash = true
+			usage.averageHash = utils.AverageHash(img)
+		}
+	}
+
+	return usage, true
+}
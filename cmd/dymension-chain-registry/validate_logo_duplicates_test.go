@@ -0,0 +1,159 @@
+package dymension_chain_registry
+
+import (
+	"bytes"
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_findDuplicatedLogos(t *testing.T) {
+	repoDir := t.TempDir()
+
+	const (
+		patternDym      = 0x0F0F0F0F0F0F0F0F
+		patternRollApp1 = 0x00000000FFFFFFFF
+		patternRollApp2 = 0x00FF00FF00FF00FF
+		patternRollApp3 = 0x3333333333333333
+	)
+
+	// addChain writes the logo files of the chain, logos are given as pattern of the image per logo file name
+	index := &registryIndex{}
+	addChain := func(dirName string, cd valtypes.ChainDefinition, logos map[string]uint64) {
+		chainPath := filepath.Join(repoDir, dirName)
+		for name, pattern := range logos {
+			writeTestPatternPng(t, filepath.Join(chainPath, name), pattern)
+		}
+		index.add(dirName, chainPath, filepath.Join(chainPath, dirName+".json"), cd)
+	}
+
+	addChain("dymension", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeHub),
+		Logo: "dym.png",
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "DYM", BaseDenom: "adym", Type: "main", Logo: "dym.png"},
+		},
+	}, map[string]uint64{"dym.png": patternDym})
+
+	rollAppCurrencies := func(channel string, mainLogo string) []valtypes.CurrencyChainDefinition {
+		return []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "RA", BaseDenom: "ara", Type: "main", Logo: mainLogo},
+			{DisplayDenom: "DYM", BaseDenom: utils.IbcDenom("transfer", channel, "adym"), Type: "regular", Logo: "dym.png"},
+		}
+	}
+
+	addChain("rollapp1", valtypes.ChainDefinition{
+		Type:       string(valtypes.ChainTypeRollApp),
+		Logo:       "ra.png",
+		IBC:        &valtypes.IbcChainDefinition{Channel: "channel-0"},
+		Currencies: rollAppCurrencies("channel-0", "ra.png"),
+	}, map[string]uint64{"ra.png": patternRollApp1, "dym.png": patternDym})
+
+	addChain("rollapp2", valtypes.ChainDefinition{
+		Type:       string(valtypes.ChainTypeRollApp),
+		Logo:       "ra.png",
+		IBC:        &valtypes.IbcChainDefinition{Channel: "channel-1"},
+		Currencies: rollAppCurrencies("channel-1", "ra.png"),
+	}, map[string]uint64{"ra.png": patternRollApp2, "dym.png": patternDym})
+
+	addChain("rollapp3", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeRollApp),
+		Logo: "ra.png",
+		IBC:  &valtypes.IbcChainDefinition{Channel: "channel-2"},
+		// DYM voucher of other channel, so it is not the same asset
+		Currencies: rollAppCurrencies("channel-9", "ra.png"),
+	}, map[string]uint64{"ra.png": patternRollApp3, "dym.png": patternDym})
+
+	addChain("unrelated", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeRegular),
+		Logo: "logo.png",
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "OTHER", BaseDenom: "uother", Type: "main", Logo: "logo.png"},
+		},
+	}, map[string]uint64{"logo.png": patternDym})
+
+	issues := findDuplicatedLogos(index, repoDir, valtypes.DefaultRuleConfig().Logo)
+
+	var gotIssues []string
+	for _, issue := range issues {
+		gotIssues = append(gotIssues, issue.chain.dirName+": "+strings.TrimSuffix(fmt.Sprintln(issue.message...), "\n"))
+	}
+
+	usageDesc := func(dirName, kind, logo string) string {
+		return fmt.Sprintf("%s (%s)", kind, filepath.Join(repoDir, dirName, logo))
+	}
+	require.ElementsMatch(t, []string{
+		// chain logo of the hub and DYM vouchers of rollapp1 and rollapp2 are related to DYM of the hub,
+		// the DYM voucher of rollapp3 is not because it is received over other channel
+		"rollapp3: The " + usageDesc("rollapp3", "logo of currency DYM of chain rollapp3", "dym.png") + " is byte-identical to the " + usageDesc("dymension", "logo of chain dymension", "dym.png") + " which is unrelated",
+		"unrelated: The " + usageDesc("unrelated", "logo of chain unrelated", "logo.png") + " is byte-identical to the " + usageDesc("dymension", "logo of chain dymension", "dym.png") + " which is unrelated",
+		"unrelated: The " + usageDesc("unrelated", "logo of currency OTHER of chain unrelated", "logo.png") + " is byte-identical to the " + usageDesc("dymension", "logo of chain dymension", "dym.png") + " which is unrelated",
+	}, gotIssues)
+}
+
+func Test_loadLogoUsage_HugeDeclaredDimensions(t *testing.T) {
+	repoDir := t.TempDir()
+	chainPath := filepath.Join(repoDir, "foo")
+	require.NoError(t, os.Mkdir(chainPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(chainPath, "logo.png"), pngDeclaringDimensions(t, 50000, 50000), 0o644))
+
+	usage, ok := loadLogoUsage(&indexedChain{chainPath: chainPath}, nil, "logo.png", repoDir, valtypes.DefaultRuleConfig().Logo)
+	require.True(t, ok)
+	require.False(t, usage.hasAvgHash)
+}
+
+func Test_loadLogoUsage_RejectedLogoFile(t *testing.T) {
+	repoDir := t.TempDir()
+	chainPath := filepath.Join(repoDir, "mainnet", "foo")
+	writeTestPng(t, filepath.Join(chainPath, "logo.png"), 64, 64)
+	writeTestPng(t, filepath.Join(filepath.Dir(repoDir), filepath.Base(repoDir)+"-outside.png"), 64, 64)
+	require.NoError(t, os.Symlink(os.DevNull, filepath.Join(chainPath, "device.png")))
+	require.NoError(t, os.Symlink("/dev/zero", filepath.Join(chainPath, "zero.png")))
+
+	withMaxBytes := valtypes.DefaultRuleConfig().Logo
+	withMaxBytes.MaxBytes = 10
+
+	tests := []struct {
+		name      string
+		logo      string
+		logoRules valtypes.LogoRuleConfig
+		want      bool
+	}{
+		{name: "valid", logo: "logo.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: true},
+		{name: "outside the repository", logo: "../../../" + filepath.Base(repoDir) + "-outside.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "symlink to device", logo: "device.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "symlink to endless device", logo: "zero.png", logoRules: valtypes.LogoRuleConfig{}, want: false},
+		{name: "larger than the size limit", logo: "logo.png", logoRules: withMaxBytes, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := loadLogoUsage(&indexedChain{chainPath: chainPath}, nil, tt.logo, repoDir, tt.logoRules)
+			require.Equal(t, tt.want, ok)
+		})
+	}
+}
+
+// writeTestPatternPng writes a PNG divided into 8x8 blocks, block i is white if bit i of the pattern is set, black otherwise
+func writeTestPatternPng(t *testing.T, filePath string, pattern uint64) {
+	const size = 64
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if pattern&(1<<uint((y*8/size)*8+x*8/size)) != 0 {
+				img.Set(x, y, color.White)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+	require.NoError(t, os.WriteFile(filePath, buf.Bytes(), 0o644))
+}
@@ -0,0 +1,188 @@
+package dymension_chain_registry
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// isValidSvgLogoContent parses the SVG logo and rejects content which can be abused when the logo is rendered
+// directly in web frontends: scripts, event handlers, javascript URLs, external references and embedded foreign objects.
+// Values set by animation elements are checked as well.
+func isValidSvgLogoContent(bz []byte, logoPath string) bool {
+	decoder := xml.NewDecoder(bytes.NewReader(bz))
+	decoder.Strict = true
+
+	var foundRoot bool
+	var insideStyle int
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			utils.PrintlnStdErr("ERR: SVG logo is not a valid XML document:", logoPath, err)
+			return false
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			elementName := strings.ToLower(t.Name.Local)
+
+			if !foundRoot {
+				foundRoot = true
+				if elementName != "svg" {
+					utils.PrintlnStdErr("ERR: SVG logo root element must be <svg>, got <"+t.Name.Local+">:", logoPath)
+					return false
+				}
+				if !hasXmlAttr(t, "viewBox") {
+					utils.PrintlnStdErr("ERR: SVG logo root element must have viewBox attribute:", logoPath)
+					return false
+				}
+			}
+
+			switch elementName {
+			case "script":
+				utils.PrintlnStdErr("ERR: SVG logo must not contain <script> element:", logoPath)
+				return false
+			case "foreignobject", "iframe", "embed", "object":
+				utils.PrintlnStdErr("ERR: SVG logo must not contain embedded foreign object <"+t.Name.Local+">:", logoPath)
+				return false
+			case "style":
+				insideStyle++
+			}
+
+			// animation elements set the value of the target attribute, so their values are checked as if they were
+			// the value of the target attribute
+			var animatedAttrName string
+			if isSvgAnimationElement(elementName) {
+				for _, attr := range t.Attr {
+					if strings.EqualFold(attr.Name.Local, "attributeName") {
+						animatedAttrName = strings.ToLower(strings.TrimSpace(attr.Value))
+						// strip namespace prefix, e.g. "xlink:href"
+						animatedAttrName = animatedAttrName[strings.LastIndex(animatedAttrName, ":")+1:]
+					}
+				}
+				if strings.HasPrefix(animatedAttrName, "on") {
+					utils.PrintlnStdErr("ERR: SVG logo must not animate event handler attribute", animatedAttrName, "on element <"+t.Name.Local+">:", logoPath)
+					return false
+				}
+			}
+
+			for _, attr := range t.Attr {
+				attrName := strings.ToLower(attr.Name.Local)
+				attrValue := strings.ToLower(strings.TrimSpace(attr.Value))
+
+				if strings.HasPrefix(attrName, "on") {
+					utils.PrintlnStdErr("ERR: SVG logo must not contain event handler attribute", attr.Name.Local, "on element <"+t.Name.Local+">:", logoPath)
+					return false
+				}
+				if containsJavascriptUrl(attrValue) {
+					utils.PrintlnStdErr("ERR: SVG logo must not contain javascript URL in attribute", attr.Name.Local, "on element <"+t.Name.Local+">:", logoPath)
+					return false
+				}
+				if attrName == "href" && !isSafeSvgReference(attrValue) {
+					utils.PrintlnStdErr("ERR: SVG logo must not contain external reference", attr.Value, "on element <"+t.Name.Local+">:", logoPath)
+					return false
+				}
+				if containsExternalCssUrl(attrValue) || (attrName == "style" && strings.Contains(attrValue, "@import")) {
+					utils.PrintlnStdErr("ERR: SVG logo must not contain external reference in attribute", attr.Name.Local, "on element <"+t.Name.Local+">:", logoPath)
+					return false
+				}
+				if animatedAttrName == "href" && isSvgAnimationValueAttr(attrName) {
+					for _, value := range strings.Split(attrValue, ";") {
+						if !isSafeSvgReference(strings.TrimSpace(value)) {
+							utils.PrintlnStdErr("ERR: SVG logo must not animate to external reference", attr.Value, "on element <"+t.Name.Local+">:", logoPath)
+							return false
+						}
+					}
+				}
+			}
+		case xml.EndElement:
+			if strings.EqualFold(t.Name.Local, "style") && insideStyle > 0 {
+				insideStyle--
+			}
+		case xml.CharData:
+			if insideStyle > 0 {
+				css := strings.ToLower(string(t))
+				if containsJavascriptUrl(css) || containsExternalCssUrl(css) || strings.Contains(css, "@import") {
+					utils.PrintlnStdErr("ERR: SVG logo must not contain external reference or javascript URL in <style> element:", logoPath)
+					return false
+				}
+			}
+		case xml.Directive:
+			if strings.Contains(strings.ToUpper(string(t)), "ENTITY") {
+				utils.PrintlnStdErr("ERR: SVG logo must not declare XML entities:", logoPath)
+				return false
+			}
+		}
+	}
+
+	if !foundRoot {
+		utils.PrintlnStdErr("ERR: SVG logo does not contain any element:", logoPath)
+		return false
+	}
+
+	return true
+}
+
+func hasXmlAttr(element xml.StartElement, name string) bool {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name && strings.TrimSpace(attr.Value) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// isSvgAnimationElement returns true if the element changes the value of another attribute of its target element
+func isSvgAnimationElement(lowerElementName string) bool {
+	switch lowerElementName {
+	case "animate", "set", "animatemotion", "animatetransform", "animatecolor":
+		return true
+	default:
+		return false
+	}
+}
+
+// isSvgAnimationValueAttr returns true if the attribute of an animation element holds the value(s) to be set
+func isSvgAnimationValueAttr(lowerAttrName string) bool {
+	switch lowerAttrName {
+	case "values", "to", "from", "by":
+		return true
+	default:
+		return false
+	}
+}
+
+// containsJavascriptUrl checks for "javascript:" scheme, white spaces within the scheme are ignored like browsers do
+func containsJavascriptUrl(lowerValue string) bool {
+	return strings.Contains(regexp.MustCompile(`\s+`).ReplaceAllString(lowerValue, ""), "javascript:")
+}
+
+// isSafeSvgReference accepts only references to elements within the same document and embedded raster images
+func isSafeSvgReference(lowerHref string) bool {
+	if lowerHref == "" || strings.HasPrefix(lowerHref, "#") {
+		return true
+	}
+	for _, prefix := range []string{"data:image/png", "data:image/jpeg", "data:image/jpg", "data:image/gif", "data:image/webp"} {
+		if strings.HasPrefix(lowerHref, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsExternalCssUrl checks for CSS url(...) which does not point to an element within the same document
+func containsExternalCssUrl(lowerCss string) bool {
+	for _, match := range regexp.MustCompile(`url\(\s*['"]?([^'")\s]*)`).FindAllStringSubmatch(lowerCss, -1) {
+		if !isSafeSvgReference(match[1]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package dymension_chain_registry
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_isValidSvgLogoContent(t *testing.T) {
+	const svgOpen = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 64 64">`
+	const svgClose = `</svg>`
+
+	tests := []struct {
+		name string
+		svg  string
+		want bool
+	}{
+		{name: "valid", svg: svgOpen + `<circle cx="32" cy="32" r="16" fill="#123456"/>` + svgClose, want: true},
+		{name: "internal reference", svg: svgOpen + `<defs><linearGradient id="g"/></defs><use href="#g"/><rect fill="url(#g)" width="1" height="1"/>` + svgClose, want: true},
+		{name: "embedded raster image", svg: svgOpen + `<image href="data:image/png;base64,iVBORw0KGgo="/>` + svgClose, want: true},
+		{name: "style without external reference", svg: svgOpen + `<style>.a { fill: url(#g); }</style><rect class="a" style="fill: red"/>` + svgClose, want: true},
+		{name: "animation of color", svg: svgOpen + `<rect><animate attributeName="fill" values="red;blue" dur="1s"/></rect>` + svgClose, want: true},
+		{name: "animation of internal reference", svg: svgOpen + `<a><set attributeName="href" to="#g"/></a>` + svgClose, want: true},
+
+		{name: "missing viewBox", svg: `<svg xmlns="http://www.w3.org/2000/svg"></svg>`, want: false},
+		{name: "empty viewBox", svg: `<svg xmlns="http://www.w3.org/2000/svg" viewBox=" "></svg>`, want: false},
+		{name: "root is not svg", svg: `<html viewBox="0 0 64 64"></html>`, want: false},
+		{name: "not XML", svg: `<svg viewBox="0 0 64 64">`, want: false},
+		{name: "empty", svg: ``, want: false},
+
+		{name: "script", svg: svgOpen + `<script>alert(1)</script>` + svgClose, want: false},
+		{name: "script in upper case", svg: svgOpen + `<SCRIPT>alert(1)</SCRIPT>` + svgClose, want: false},
+		{name: "event handler", svg: svgOpen + `<rect onclick="alert(1)"/>` + svgClose, want: false},
+		{name: "event handler on root", svg: `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 64 64" onload="alert(1)"></svg>`, want: false},
+		{name: "javascript URL", svg: svgOpen + `<a href="javascript:alert(1)"><rect/></a>` + svgClose, want: false},
+		{name: "javascript URL by xlink", svg: svgOpen + `<a xlink:href="javascript:alert(1)"><rect/></a>` + svgClose, want: false},
+		{name: "javascript URL obfuscated by white spaces", svg: svgOpen + `<a href=" java&#9;script :alert(1)"><rect/></a>` + svgClose, want: false},
+		{name: "javascript URL obfuscated by entities", svg: svgOpen + `<a href="&#106;&#x61;vascript&#58;alert(1)"><rect/></a>` + svgClose, want: false},
+		{name: "javascript URL in mixed case", svg: svgOpen + `<a href="JaVaScRiPt:alert(1)"><rect/></a>` + svgClose, want: false},
+		{name: "external href", svg: svgOpen + `<image href="https://example.com/logo.png"/>` + svgClose, want: false},
+		{name: "external xlink href", svg: svgOpen + `<use xlink:href="https://example.com/sprite.svg#icon"/>` + svgClose, want: false},
+		{name: "embedded SVG image", svg: svgOpen + `<image href="data:image/svg+xml;base64,PHN2Zz4="/>` + svgClose, want: false},
+		{name: "CSS url in style attribute", svg: svgOpen + `<rect style="fill: url(https://example.com/x.svg#p)"/>` + svgClose, want: false},
+		{name: "CSS import in style attribute", svg: svgOpen + `<rect style="@import 'https://example.com/x.css'"/>` + svgClose, want: false},
+		{name: "CSS url in presentation attribute", svg: svgOpen + `<rect fill="url(https://example.com/x.svg#p)"/>` + svgClose, want: false},
+		{name: "CSS url in style element", svg: svgOpen + `<style>rect { fill: url("https://example.com/x.svg#p"); }</style>` + svgClose, want: false},
+		{name: "CSS import in style element", svg: svgOpen + `<style>@import url(https://example.com/x.css);</style>` + svgClose, want: false},
+		{name: "CSS import in style element without url", svg: svgOpen + `<style>@import "https://example.com/x.css";</style>` + svgClose, want: false},
+		{name: "javascript URL in style element", svg: svgOpen + `<style>rect { background: url(javascript:alert(1)); }</style>` + svgClose, want: false},
+		{name: "foreignObject", svg: svgOpen + `<foreignObject><div xmlns="http://www.w3.org/1999/xhtml">x</div></foreignObject>` + svgClose, want: false},
+		{name: "iframe", svg: svgOpen + `<iframe src="https://example.com"/>` + svgClose, want: false},
+		{name: "entity declaration", svg: `<!DOCTYPE svg [<!ENTITY x "y">]>` + svgOpen + svgClose, want: false},
+
+		{name: "animate to javascript URL", svg: svgOpen + `<a><animate attributeName="href" values="#g;javascript:alert(1)"/></a>` + svgClose, want: false},
+		{name: "set to javascript URL", svg: svgOpen + `<a><set attributeName="href" to="javascript:alert(1)"/></a>` + svgClose, want: false},
+		{name: "set to external reference", svg: svgOpen + `<a><set attributeName="xlink:href" to="https://example.com"/></a>` + svgClose, want: false},
+		{name: "animate from external reference", svg: svgOpen + `<image><animate attributeName="href" from="https://example.com/a.png" to="#g"/></image>` + svgClose, want: false},
+		{name: "animate to external CSS url", svg: svgOpen + `<rect><animate attributeName="fill" to="url(https://example.com/x.svg#p)"/></rect>` + svgClose, want: false},
+		{name: "set event handler", svg: svgOpen + `<rect><set attributeName="onclick" to="alert(1)"/></rect>` + svgClose, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isValidSvgLogoContent([]byte(tt.svg), "logo.svg"))
+		})
+	}
+}
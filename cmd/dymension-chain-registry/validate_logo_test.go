@@ -0,0 +1,179 @@
+package dymension_chain_registry
+
+import (
+	"bytes"
+	"encoding/binary"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_isWithinDir(t *testing.T) {
+	tests := []struct {
+		name       string
+		targetPath string
+		dir        string
+		want       bool
+	}{
+		{name: "direct child", targetPath: "/repo/chain/logo.png", dir: "/repo/chain", want: true},
+		{name: "nested child", targetPath: "/repo/chain/assets/logo.png", dir: "/repo/chain", want: true},
+		{name: "not cleaned child", targetPath: "/repo/chain/assets/../logo.png", dir: "/repo/chain/", want: true},
+		{name: "directory itself", targetPath: "/repo/chain", dir: "/repo/chain", want: false},
+		{name: "parent", targetPath: "/repo", dir: "/repo/chain", want: false},
+		{name: "escaping by dot dot", targetPath: "/repo/chain/../other/logo.png", dir: "/repo/chain", want: false},
+		{name: "sibling with same prefix", targetPath: "/repo/chain2/logo.png", dir: "/repo/chain", want: false},
+		{name: "file named with dot dot prefix", targetPath: "/repo/chain/..logo.png", dir: "/repo/chain", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isWithinDir(filepath.FromSlash(tt.targetPath), filepath.FromSlash(tt.dir)))
+		})
+	}
+}
+
+func Test_isValidLogo_Location(t *testing.T) {
+	repoDir := t.TempDir()
+	chainPath := filepath.Join(repoDir, "mainnet", "foo")
+	writeTestPng(t, filepath.Join(chainPath, "logo.png"), 64, 64)
+	writeTestPng(t, filepath.Join(chainPath, "assets", "logo.png"), 64, 64)
+	writeTestPng(t, filepath.Join(repoDir, "mainnet", "bar", "logo.png"), 64, 64)
+	writeTestPng(t, filepath.Join(repoDir, "shared", "logo.png"), 64, 64)
+	writeTestPng(t, filepath.Join(filepath.Dir(repoDir), filepath.Base(repoDir)+"-outside.png"), 64, 64)
+	require.NoError(t, os.Symlink(
+		filepath.Join(filepath.Dir(repoDir), filepath.Base(repoDir)+"-outside.png"),
+		filepath.Join(chainPath, "symlink-outside.png"),
+	))
+	require.NoError(t, os.Symlink(
+		filepath.Join(repoDir, "shared", "logo.png"),
+		filepath.Join(chainPath, "symlink-inside.png"),
+	))
+
+	withSharedAssetsDir := valtypes.DefaultRuleConfig().Logo
+	withSharedAssetsDir.SharedAssetsDir = "shared"
+
+	tests := []struct {
+		name      string
+		logo      string
+		logoRules valtypes.LogoRuleConfig
+		want      bool
+	}{
+		{name: "empty", logo: "", logoRules: valtypes.DefaultRuleConfig().Logo, want: true},
+		{name: "within chain directory", logo: "logo.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: true},
+		{name: "nested within chain directory", logo: "assets/logo.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: true},
+		{name: "absolute path", logo: filepath.Join(chainPath, "logo.png"), logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "other chain directory", logo: "../bar/logo.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "shared assets directory not configured", logo: "../../shared/logo.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "shared assets directory", logo: "../../shared/logo.png", logoRules: withSharedAssetsDir, want: true},
+		{name: "other chain directory with shared assets directory", logo: "../bar/logo.png", logoRules: withSharedAssetsDir, want: false},
+		{name: "not found", logo: "missing.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "symlink pointing outside the repository", logo: "symlink-outside.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "symlink pointing inside the repository", logo: "symlink-inside.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isValidLogo(tt.logo, chainPath, repoDir, tt.logoRules))
+		})
+	}
+}
+
+func Test_isValidLogo_File(t *testing.T) {
+	repoDir := t.TempDir()
+	chainPath := filepath.Join(repoDir, "mainnet", "foo")
+	writeTestPng(t, filepath.Join(chainPath, "logo.png"), 64, 64)
+	require.NoError(t, os.MkdirAll(filepath.Join(chainPath, "directory.png"), 0o755))
+	require.NoError(t, os.Symlink(os.DevNull, filepath.Join(chainPath, "device.png")))
+
+	logoSize := int64(len(encodeTestPng(t, 64, 64)))
+	withMaxBytes := func(maxBytes int64) valtypes.LogoRuleConfig {
+		logoRules := valtypes.DefaultRuleConfig().Logo
+		logoRules.MaxBytes = maxBytes
+		return logoRules
+	}
+
+	tests := []struct {
+		name      string
+		logo      string
+		logoRules valtypes.LogoRuleConfig
+		want      bool
+	}{
+		{name: "regular file", logo: "logo.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: true},
+		{name: "at the size limit", logo: "logo.png", logoRules: withMaxBytes(logoSize), want: true},
+		{name: "larger than the size limit", logo: "logo.png", logoRules: withMaxBytes(logoSize - 1), want: false},
+		{name: "directory", logo: "directory.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "symlink to device", logo: "device.png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isValidLogo(tt.logo, chainPath, repoDir, tt.logoRules))
+		})
+	}
+}
+
+func Test_readLogoFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "logo.png")
+	require.NoError(t, os.WriteFile(filePath, []byte("0123456789"), 0o644))
+
+	bz, err := readLogoFile(filePath, 10)
+	require.NoError(t, err)
+	require.Equal(t, "0123456789", string(bz))
+
+	bz, err = readLogoFile(filePath, 0)
+	require.NoError(t, err)
+	require.Len(t, bz, 10)
+
+	_, err = readLogoFile(filePath, 9)
+	require.Error(t, err)
+}
+
+func Test_isValidRasterLogoContent(t *testing.T) {
+	noDimensionRules := valtypes.LogoRuleConfig{}
+
+	tests := []struct {
+		name      string
+		bz        []byte
+		ext       string
+		logoRules valtypes.LogoRuleConfig
+		want      bool
+	}{
+		{name: "valid", bz: encodeTestPng(t, 64, 64), ext: ".png", logoRules: valtypes.DefaultRuleConfig().Logo, want: true},
+		{name: "extension mismatch", bz: encodeTestPng(t, 64, 64), ext: ".jpg", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "too small", bz: encodeTestPng(t, 32, 32), ext: ".png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "not square", bz: encodeTestPng(t, 64, 128), ext: ".png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "not an image", bz: []byte("not an image"), ext: ".png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "truncated", bz: encodeTestPng(t, 64, 64)[:60], ext: ".png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "huge declared dimensions", bz: pngDeclaringDimensions(t, 50000, 50000), ext: ".png", logoRules: valtypes.DefaultRuleConfig().Logo, want: false},
+		{name: "huge declared dimensions without dimension rules", bz: pngDeclaringDimensions(t, 50000, 50000), ext: ".png", logoRules: noDimensionRules, want: false},
+		{name: "declared dimensions not matching the pixel data", bz: pngDeclaringDimensions(t, 64, 64), ext: ".png", logoRules: noDimensionRules, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isValidRasterLogoContent(tt.bz, tt.ext, "logo"+tt.ext, tt.logoRules))
+		})
+	}
+}
+
+func encodeTestPng(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+// pngDeclaringDimensions returns a small PNG which header declares the dimensions, without the pixel data to match
+func pngDeclaringDimensions(t *testing.T, width, height uint32) []byte {
+	bz := encodeTestPng(t, 1, 1)
+	// IHDR chunk: length (4) + type (4) at offset 8, width and height at offset 16, CRC over type and data at offset 29
+	binary.BigEndian.PutUint32(bz[16:20], width)
+	binary.BigEndian.PutUint32(bz[20:24], height)
+	binary.BigEndian.PutUint32(bz[29:33], crc32.ChecksumIEEE(bz[12:29]))
+	return bz
+}
+
+func writeTestPng(t *testing.T, filePath string, width, height int) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+	require.NoError(t, os.WriteFile(filePath, encodeTestPng(t, width, height), 0o644))
+}
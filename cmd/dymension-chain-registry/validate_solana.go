@@ -0,0 +1,22 @@
+package dymension_chain_registry
+
+import (
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+)
+
+// solanaCoinType is the SLIP-44 coin type registered for Solana
+const solanaCoinType = 501
+
+// isValidSolanaMintAddress checks the address is a base58-encoded 32 bytes public key
+func isValidSolanaMintAddress(address string) bool {
+	bz, err := utils.DecodeBase58(address)
+	if err != nil {
+		utils.PrintlnStdErr("ERR: Solana mint address must be base58-encoded:", address, err)
+		return false
+	}
+	if len(bz) != 32 {
+		utils.PrintlnStdErr("ERR: Solana mint address must be a 32 bytes public key, got", len(bz), "bytes:", address)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// findStrayFiles walks the chain directory and returns issues for files which should not be there:
+// unreferenced logo images, extra JSON files, nested directories, hidden files and oversized files.
+func findStrayFiles(chainPath string, chainDefinitionFile string, cd valtypes.ChainDefinition, rules valtypes.StrayFilesRuleConfig) (issues []string) {
+	if rules.Disabled {
+		return nil
+	}
+
+	referencedFiles := make(map[string]bool)
+	referencedFiles[filepath.Clean(chainDefinitionFile)] = true
+	addReferencedLogo := func(logo string) {
+		if logo == "" {
+			return
+		}
+		referencedFiles[filepath.Join(chainPath, filepath.FromSlash(logo))] = true
+	}
+	addReferencedLogo(cd.Logo)
+	for _, currency := range cd.Currencies {
+		addReferencedLogo(currency.Logo)
+	}
+
+	isAllowedFile := func(name string) bool {
+		for _, pattern := range rules.AllowedFiles {
+			if matched, _ := filepath.Match(pattern, name); matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	containsReferencedFile := func(dirPath string) bool {
+		for referencedFile := range referencedFiles {
+			if strings.HasPrefix(referencedFile, dirPath+string(os.PathSeparator)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	cleanChainPath := filepath.Clean(chainPath)
+	err := filepath.WalkDir(cleanChainPath, func(filePath string, d os.DirEntry, err error) error {
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("Failed to read %s: %v", filePath, err))
+			return nil
+		}
+		if filePath == cleanChainPath {
+			return nil
+		}
+
+		if strings.HasPrefix(d.Name(), ".") && !isAllowedFile(d.Name()) {
+			issues = append(issues, fmt.Sprintf("Hidden file or directory is not allowed: %s", filePath))
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			if !containsReferencedFile(filePath) {
+				issues = append(issues, fmt.Sprintf("Nested directory does not contain any referenced logo: %s", filePath))
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if rules.MaxFileSizeBytes > 0 {
+			fi, err := d.Info()
+			if err != nil {
+				issues = append(issues, fmt.Sprintf("Failed to get stat of %s: %v", filePath, err))
+			} else if fi.Size() > rules.MaxFileSizeBytes {
+				issues = append(issues, fmt.Sprintf("Oversized file %s: %d bytes, maximum allowed is %d bytes", filePath, fi.Size(), rules.MaxFileSizeBytes))
+			}
+		}
+
+		if referencedFiles[filePath] || isAllowedFile(d.Name()) {
+			return nil
+		}
+
+		switch strings.ToLower(filepath.Ext(filePath)) {
+		case ".png", ".jpg", ".jpeg", ".svg", ".gif", ".webp":
+			issues = append(issues, fmt.Sprintf("Logo image is not referenced by the chain or any currency: %s", filePath))
+		case ".json":
+			issues = append(issues, fmt.Sprintf("Extra JSON file, only %s is expected: %s", filepath.Base(chainDefinitionFile), filePath))
+		default:
+			issues = append(issues, fmt.Sprintf("Unknown file: %s", filePath))
+		}
+
+		return nil
+	})
+	if err != nil {
+		issues = append(issues, fmt.Sprintf("Failed to walk chain directory %s: %v", chainPath, err))
+	}
+
+	return
+}
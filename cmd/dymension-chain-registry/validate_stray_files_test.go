@@ -0,0 +1,126 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_findStrayFiles(t *testing.T) {
+	cd := valtypes.ChainDefinition{
+		Logo: "logo.png",
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{Logo: "assets/currencies/usdc.svg"},
+		},
+	}
+	defaultRules := valtypes.DefaultRuleConfig().StrayFiles
+
+	tests := []struct {
+		name string
+		// files to be created within the chain directory, the chain definition file and referenced logos are always created
+		files      map[string]int
+		rules      valtypes.StrayFilesRuleConfig
+		wantIssues []string
+	}{
+		{
+			name:  "only referenced files",
+			rules: defaultRules,
+		},
+		{
+			name:       "unreferenced logo next to referenced logo in nested directory",
+			files:      map[string]int{"assets/currencies/usdt.png": 1},
+			rules:      defaultRules,
+			wantIssues: []string{"Logo image is not referenced by the chain or any currency: assets/currencies/usdt.png"},
+		},
+		{
+			name:       "nested directory without referenced logo",
+			files:      map[string]int{"old/logo.png": 1, "old/deeper/logo.png": 1},
+			rules:      defaultRules,
+			wantIssues: []string{"Nested directory does not contain any referenced logo: old"},
+		},
+		{
+			name:       "extra JSON file",
+			files:      map[string]int{"backup.json": 1},
+			rules:      defaultRules,
+			wantIssues: []string{"Extra JSON file, only foo.json is expected: backup.json"},
+		},
+		{
+			name:       "unknown file",
+			files:      map[string]int{"notes.txt": 1},
+			rules:      defaultRules,
+			wantIssues: []string{"Unknown file: notes.txt"},
+		},
+		{
+			name:       "hidden file",
+			files:      map[string]int{".DS_Store": 1},
+			rules:      defaultRules,
+			wantIssues: []string{"Hidden file or directory is not allowed: .DS_Store"},
+		},
+		{
+			name:       "hidden directory",
+			files:      map[string]int{".git/config": 1},
+			rules:      defaultRules,
+			wantIssues: []string{"Hidden file or directory is not allowed: .git"},
+		},
+		{
+			name:  "allowed file patterns",
+			files: map[string]int{"README.md": 1, "NOTES-v1.txt": 1, ".gitkeep": 1, "assets/currencies/README.md": 1},
+			rules: valtypes.StrayFilesRuleConfig{
+				AllowedFiles: []string{"README.md", "NOTES-*.txt", ".git*"},
+			},
+		},
+		{
+			name:  "allowed file pattern does not match the path",
+			files: map[string]int{"docs/README.md": 1},
+			rules: valtypes.StrayFilesRuleConfig{
+				AllowedFiles: []string{"docs/*"},
+			},
+			wantIssues: []string{"Nested directory does not contain any referenced logo: docs"},
+		},
+		{
+			name:  "oversized file",
+			files: map[string]int{"README.md": 11},
+			rules: valtypes.StrayFilesRuleConfig{
+				MaxFileSizeBytes: 10,
+				AllowedFiles:     []string{"README.md"},
+			},
+			wantIssues: []string{"Oversized file README.md: 11 bytes, maximum allowed is 10 bytes"},
+		},
+		{
+			name:  "disabled",
+			files: map[string]int{"notes.txt": 1, ".DS_Store": 1},
+			rules: valtypes.StrayFilesRuleConfig{
+				Disabled: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chainPath := t.TempDir()
+			chainDefinitionFile := filepath.Join(chainPath, "foo.json")
+
+			createFile := func(name string, size int) {
+				filePath := filepath.Join(chainPath, filepath.FromSlash(name))
+				require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+				require.NoError(t, os.WriteFile(filePath, []byte(strings.Repeat("x", size)), 0o644))
+			}
+			createFile("foo.json", 2)
+			createFile(cd.Logo, 1)
+			createFile(cd.Currencies[0].Logo, 1)
+			for name, size := range tt.files {
+				createFile(name, size)
+			}
+
+			// issues report absolute paths, expected issues are declared with paths relative to the chain directory
+			var gotIssues []string
+			for _, issue := range findStrayFiles(chainPath, chainDefinitionFile, cd, tt.rules) {
+				gotIssues = append(gotIssues, filepath.ToSlash(strings.ReplaceAll(issue, chainPath+string(filepath.Separator), "")))
+			}
+
+			require.ElementsMatch(t, tt.wantIssues, gotIssues)
+		})
+	}
+}
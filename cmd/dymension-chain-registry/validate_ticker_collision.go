@@ -0,0 +1,50 @@
+package dymension_chain_registry
+
+import (
+	"fmt"
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"strings"
+)
+
+// findTickerCollisions reports currencies which display denom is also used by a currency of another chain
+// with different base denom, while both are not the same bridged asset (linked by IBC representation or bridge denom).
+// The index holds the chains of a single group, tickers shared across groups (e.g. mainnet and testnet) are not compared.
+func findTickerCollisions(index *registryIndex, rules valtypes.TickerCollisionRuleConfig) (issues []registryIssue) {
+	if rules.Disabled {
+		return nil
+	}
+
+	allowedTickers := make(map[string]bool)
+	for _, ticker := range rules.AllowedTickers {
+		allowedTickers[strings.ToUpper(ticker)] = true
+	}
+
+	currenciesByTicker := make(map[string][]indexedCurrency)
+	for _, c := range index.currencies() {
+		ticker := strings.ToUpper(c.currency.DisplayDenom)
+		if ticker == "" || allowedTickers[ticker] {
+			continue
+		}
+
+		for _, existing := range currenciesByTicker[ticker] {
+			if existing.chain == c.chain || existing.currency.BaseDenom == c.currency.BaseDenom {
+				continue
+			}
+			if index.assetGroupOf(existing.chain, existing.currency.BaseDenom) == index.assetGroupOf(c.chain, c.currency.BaseDenom) {
+				continue
+			}
+			issues = append(issues, registryIssue{
+				chain: c.chain,
+				message: []any{fmt.Sprintf(
+					"Ticker collision, display denom %s of base denom %s is also used by unrelated base denom %s of chain %s, link them by IBC representation or bridge denom if they are the same asset",
+					c.currency.DisplayDenom, c.currency.BaseDenom, existing.currency.BaseDenom, existing.chain.dirName,
+				)},
+			})
+			break
+		}
+
+		currenciesByTicker[ticker] = append(currenciesByTicker[ticker], c)
+	}
+
+	return
+}
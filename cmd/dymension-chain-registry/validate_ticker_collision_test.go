@@ -0,0 +1,82 @@
+package dymension_chain_registry
+
+import (
+	valtypes "github.com/bcdevtools/chain-registry-validation-tool/cmd/dymension-chain-registry/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_findTickerCollisions(t *testing.T) {
+	const (
+		usdcContract = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+		ibcOfRollApp = "ibc/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+	)
+
+	index := &registryIndex{}
+	index.add("ethereum", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeEVM),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "USDC", BaseDenom: usdcContract, Type: "regular"},
+		},
+	})
+	index.add("rollapp1", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeRollApp),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "RA", BaseDenom: "ara", IbcRepresentation: ibcOfRollApp, Type: "main"},
+			// same asset as USDC of ethereum, linked by bridge denom
+			{DisplayDenom: "USDC", BaseDenom: "hyperlane/0x01", BridgeDenom: usdcContract, Type: "regular"},
+			{DisplayDenom: "stable", BaseDenom: "astable", Type: "regular"},
+		},
+	})
+	index.add("rollapp2", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeRollApp),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "RA2", BaseDenom: "ara2", Type: "main"},
+			// unrelated token using the same ticker
+			{DisplayDenom: "usdc", BaseDenom: "ausdc", Type: "regular"},
+			{DisplayDenom: "STABLE", BaseDenom: "ustable", Type: "regular"},
+		},
+	})
+	index.add("dymension", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeHub),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			{DisplayDenom: "DYM", BaseDenom: "adym", Type: "main"},
+			// same asset as RA of rollapp1, linked by IBC representation
+			{DisplayDenom: "RA", BaseDenom: ibcOfRollApp, Type: "regular"},
+		},
+	})
+	index.add("rollapp3", "", "", valtypes.ChainDefinition{
+		Type: string(valtypes.ChainTypeRollApp),
+		Currencies: []valtypes.CurrencyChainDefinition{
+			// same base denom as rollapp2, not a collision
+			{DisplayDenom: "RA2", BaseDenom: "ara2", Type: "main"},
+		},
+	})
+
+	issueChains := func(issues []registryIssue) (chains []string) {
+		for _, issue := range issues {
+			chains = append(chains, issue.chain.dirName)
+		}
+		return
+	}
+
+	t.Run("unrelated assets sharing ticker are reported", func(t *testing.T) {
+		issues := findTickerCollisions(index, valtypes.TickerCollisionRuleConfig{})
+		require.Equal(t, []string{"rollapp2", "rollapp2"}, issueChains(issues))
+		require.Contains(t, issues[0].message[0], "display denom usdc of base denom ausdc is also used by unrelated base denom "+usdcContract+" of chain ethereum")
+		require.Contains(t, issues[1].message[0], "display denom STABLE of base denom ustable is also used by unrelated base denom astable of chain rollapp1")
+	})
+
+	t.Run("allowed tickers are case-insensitive", func(t *testing.T) {
+		issues := findTickerCollisions(index, valtypes.TickerCollisionRuleConfig{AllowedTickers: []string{"Usdc"}})
+		require.Equal(t, []string{"rollapp2"}, issueChains(issues))
+		require.Contains(t, issues[0].message[0], "display denom STABLE")
+
+		issues = findTickerCollisions(index, valtypes.TickerCollisionRuleConfig{AllowedTickers: []string{"usdc", "stable"}})
+		require.Empty(t, issues)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		require.Empty(t, findTickerCollisions(index, valtypes.TickerCollisionRuleConfig{Disabled: true}))
+	})
+}
@@ -0,0 +1,259 @@
+package dymension_chain_registry
+
+import (
+	"github.com/bcdevtools/chain-registry-validation-tool/utils"
+	"math"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	// httpUrlSchemes are the schemes allowed for REST-like endpoints
+	httpUrlSchemes = []string{"http", "https"}
+	// httpOrWsUrlSchemes are the schemes allowed for RPC endpoints, which can be served over websocket
+	httpOrWsUrlSchemes = []string{"http", "https", "ws", "wss"}
+)
+
+// urlPolicy holds the rules applied to urls of a group
+type urlPolicy struct {
+	allowedSchemes    []string
+	requireSecure     bool
+	allowPrivateHosts bool
+}
+
+// withSchemes returns a copy of the policy which allows only the provided schemes
+func (p urlPolicy) withSchemes(schemes []string) urlPolicy {
+	p.allowedSchemes = schemes
+	return p
+}
+
+func isValidUrls(urls []string, policy urlPolicy) bool {
+	if len(urls) == 1 && urls[0] == "" {
+		return true
+	}
+	var withTrailingSlash, withoutTrailingSlash int
+	for _, url := range urls {
+		if !isValidUrl(url, policy) {
+			return false
+		}
+		if strings.HasSuffix(url, "/") {
+			withTrailingSlash++
+		} else {
+			withoutTrailingSlash++
+		}
+	}
+	if withTrailingSlash > 0 && withoutTrailingSlash > 0 {
+		utils.PrintlnStdErr("ERR: urls must be consistent in using trailing slash, either all or none of them")
+		return false
+	}
+	return true
+}
+
+func isValidUrl(rawUrl string, policy urlPolicy) bool {
+	if rawUrl == "" {
+		utils.PrintlnStdErr("ERR: url can not be empty")
+		return false
+	}
+	if strings.TrimSpace(rawUrl) != rawUrl {
+		utils.PrintlnStdErr("ERR: url must not have leading or trailing spaces")
+		return false
+	}
+	if strings.Contains(rawUrl, " ") {
+		utils.PrintlnStdErr("ERR: url must not contains space")
+		return false
+	}
+
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		utils.PrintlnStdErr("ERR: url is malformed:", rawUrl, err)
+		return false
+	}
+
+	var isAllowedScheme bool
+	for _, scheme := range policy.allowedSchemes {
+		if parsedUrl.Scheme == scheme {
+			isAllowedScheme = true
+			break
+		}
+	}
+	if !isAllowedScheme {
+		utils.PrintlnStdErr("ERR: url scheme must be one of", strings.Join(policy.allowedSchemes, ", ")+":", rawUrl)
+		return false
+	}
+	if policy.requireSecure && parsedUrl.Scheme != "https" && parsedUrl.Scheme != "wss" {
+		utils.PrintlnStdErr("ERR: url must use secure scheme (https or wss):", rawUrl)
+		return false
+	}
+	if parsedUrl.Opaque != "" || !strings.HasPrefix(strings.TrimPrefix(rawUrl, parsedUrl.Scheme+":"), "//") {
+		utils.PrintlnStdErr("ERR: url must have format <scheme>://<host>:", rawUrl)
+		return false
+	}
+	if parsedUrl.Hostname() == "" {
+		utils.PrintlnStdErr("ERR: url must have host:", rawUrl)
+		return false
+	}
+	if !isPublicSafeUrl(parsedUrl, policy) {
+		return false
+	}
+	// only endpoints are checked, website and faucet urls commonly contain long slugs or commit hashes
+	for _, segment := range strings.Split(parsedUrl.Path, "/") {
+		if looksLikeApiKey(segment) {
+			utils.PrintlnStdErr("ERR: url must not contain path segment which looks like an API key:", parsedUrl.Scheme+"://"+parsedUrl.Host+"/...")
+			return false
+		}
+	}
+	if parsedUrl.RawQuery != "" || parsedUrl.ForceQuery {
+		utils.PrintlnStdErr("ERR: url must not contain query string:", rawUrl)
+		return false
+	}
+	if parsedUrl.Fragment != "" || strings.Contains(rawUrl, "#") {
+		utils.PrintlnStdErr("ERR: url must not contain fragment:", rawUrl)
+		return false
+	}
+
+	return true
+}
+
+func isValidOptionalWebsiteUrl(websiteUrl string, policy urlPolicy) bool {
+	if websiteUrl == "" {
+		return true
+	}
+
+	if strings.TrimSpace(websiteUrl) != websiteUrl {
+		utils.PrintlnStdErr("ERR: url must not have leading or trailing spaces")
+		return false
+	}
+
+	if strings.Contains(websiteUrl, " ") {
+		utils.PrintlnStdErr("ERR: url must not contains space")
+		return false
+	}
+
+	parsedUrl, err := url.Parse(websiteUrl)
+	if err != nil {
+		utils.PrintlnStdErr("ERR: url is malformed:", websiteUrl, err)
+		return false
+	}
+
+	return isPublicSafeUrl(parsedUrl, policy)
+}
+
+// isPublicSafeUrl checks the url does not point to a local or private host, unless allowed by the policy,
+// and does not leak credentials via user info or query params.
+func isPublicSafeUrl(parsedUrl *url.URL, policy urlPolicy) bool {
+	redactedUrl := parsedUrl.Redacted()
+
+	if parsedUrl.User != nil {
+		utils.PrintlnStdErr("ERR: url must not contain credentials:", redactedUrl)
+		return false
+	}
+
+	if !policy.allowPrivateHosts {
+		if reason := privateHostReason(parsedUrl.Hostname()); reason != "" {
+			utils.PrintlnStdErr("ERR: url must not point to", reason+", only allowed for internal devnet:", redactedUrl)
+			return false
+		}
+	}
+
+	for param := range parsedUrl.Query() {
+		if regexp.MustCompile(`(?i)^(api[-_]?key|key|access[-_]?token|auth[-_]?token|token|secret|client[-_]?secret|password|passwd|pwd|auth|signature|sig)$`).MatchString(param) {
+			utils.PrintlnStdErr("ERR: url must not contain query param which looks like an API key or secret:", param)
+			return false
+		}
+	}
+
+	return true
+}
+
+// privateHostReason returns the description of the host if it is local or private, otherwise empty
+func privateHostReason(hostname string) string {
+	lowerHostname := strings.TrimSuffix(strings.ToLower(hostname), ".")
+	if lowerHostname == "localhost" || strings.HasSuffix(lowerHostname, ".localhost") {
+		return "localhost"
+	}
+	if strings.HasSuffix(lowerHostname, ".local") {
+		return "local network host"
+	}
+
+	ip := net.ParseIP(lowerHostname)
+	if ip == nil {
+		return ""
+	}
+	switch {
+	case ip.IsLoopback():
+		return "loopback IP"
+	case ip.IsPrivate():
+		return "private IP"
+	case ip.IsLinkLocalUnicast(), ip.IsLinkLocalMulticast():
+		return "link-local IP"
+	case ip.IsUnspecified():
+		return "unspecified IP"
+	}
+	return ""
+}
+
+// looksLikeApiKey detects long random tokens as used by paid RPC providers, e.g. /v3/<32 hex characters>.
+// Token must be hex, or base64-like mixing upper case, lower case and digits, and must have high entropy.
+// Words joined by dashes or underscores (slugs) and UUIDs are not considered API keys.
+func looksLikeApiKey(segment string) bool {
+	if len(segment) < 32 {
+		return false
+	}
+
+	if regexp.MustCompile(`^[a-fA-F\d]+$`).MatchString(segment) {
+		return shannonEntropy(segment) >= 3
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z\d_-]+$`).MatchString(segment) {
+		return false
+	}
+	if regexp.MustCompile(`^[a-fA-F\d]{8}-[a-fA-F\d]{4}-[a-fA-F\d]{4}-[a-fA-F\d]{4}-[a-fA-F\d]{12}$`).MatchString(segment) {
+		return false
+	}
+	if isWordsJoinedBySeparator(segment) {
+		return false
+	}
+	if !regexp.MustCompile(`\d`).MatchString(segment) || !regexp.MustCompile(`[a-z]`).MatchString(segment) || !regexp.MustCompile(`[A-Z]`).MatchString(segment) {
+		return false
+	}
+	return shannonEntropy(segment) >= 4
+}
+
+// isWordsJoinedBySeparator returns true if the segment contains dash or underscore and every part between them
+// is a single word (letters of the same case, capitalized word or digits), e.g. "my-blog-post-2024"
+func isWordsJoinedBySeparator(segment string) bool {
+	parts := regexp.MustCompile(`[-_]`).Split(segment, -1)
+	if len(parts) < 2 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if !regexp.MustCompile(`^([a-z]+|[A-Z][a-z]*|[A-Z]+|\d+)$`).MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// shannonEntropy returns the Shannon entropy of the string, in bits per character
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	var total int
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+//goland:noinspection SpellCheckingInspection
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// DecodeBase58 decodes the input using the Bitcoin base58 alphabet, which is also used by Solana and Substrate.
+// Each leading '1' character is decoded into a leading zero byte.
+func DecodeBase58(input string) ([]byte, error) {
+	if input == "" {
+		return nil, fmt.Errorf("base58 string can not be empty")
+	}
+
+	value := new(big.Int)
+	radix := big.NewInt(58)
+	for i, c := range input {
+		index := strings.IndexRune(base58Alphabet, c)
+		if index < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q at position %d", c, i)
+		}
+		value.Mul(value, radix)
+		value.Add(value, big.NewInt(int64(index)))
+	}
+
+	var leadingZeros int
+	for leadingZeros < len(input) && input[leadingZeros] == base58Alphabet[0] {
+		leadingZeros++
+	}
+
+	return append(make([]byte, leadingZeros), value.Bytes()...), nil
+}
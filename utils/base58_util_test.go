@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_DecodeBase58(t *testing.T) {
+	//goland:noinspection SpellCheckingInspection
+	tests := []struct {
+		input   string
+		want    []byte
+		wantErr bool
+	}{
+		{input: "2NEpo7TZRRrLZSi2U", want: []byte("Hello World!")},
+		{input: "1111111111", want: make([]byte, 10)},
+		{input: "11111111111111111111111111111111", want: make([]byte, 32)},
+		{input: "1112", want: []byte{0, 0, 0, 1}},
+		{input: "0OIl", wantErr: true},
+		{input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := DecodeBase58(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("Solana USDC mint", func(t *testing.T) {
+		got, err := DecodeBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+		require.NoError(t, err)
+		require.Len(t, got, 32)
+	})
+}
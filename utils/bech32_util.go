@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// MaxBech32HrpLength is the maximum length of the human-readable part of a bech32 string, defined by BIP-173
+const MaxBech32HrpLength = 83
+
+// DecodeBech32 decodes a bech32 string (BIP-173) and verifies its checksum,
+// returns the human-readable part and the data converted from 5-bit groups into bytes.
+func DecodeBech32(bech string) (hrp string, data []byte, err error) {
+	if len(bech) > 90 {
+		return "", nil, fmt.Errorf("bech32 string is too long, got %d characters, maximum is 90", len(bech))
+	}
+	if strings.ToLower(bech) != bech && strings.ToUpper(bech) != bech {
+		return "", nil, fmt.Errorf("bech32 string must not be mixed case")
+	}
+	bech = strings.ToLower(bech)
+
+	separatorIndex := strings.LastIndex(bech, "1")
+	if separatorIndex < 1 {
+		return "", nil, fmt.Errorf("bech32 string missing human-readable part")
+	}
+	if separatorIndex+7 > len(bech) {
+		return "", nil, fmt.Errorf("bech32 string is too short")
+	}
+
+	hrp = bech[:separatorIndex]
+	for _, c := range hrp {
+		if c < 33 || c > 126 {
+			return "", nil, fmt.Errorf("bech32 human-readable part contains invalid character %q", c)
+		}
+	}
+
+	values := make([]byte, 0, len(bech)-separatorIndex-1)
+	for _, c := range bech[separatorIndex+1:] {
+		index := strings.IndexRune(bech32Charset, c)
+		if index < 0 {
+			return "", nil, fmt.Errorf("bech32 data part contains invalid character %q", c)
+		}
+		values = append(values, byte(index))
+	}
+
+	if bech32Polymod(append(bech32HrpExpand(hrp), values...)) != 1 {
+		return "", nil, fmt.Errorf("bech32 checksum mismatch")
+	}
+
+	data, err = convertBits(values[:len(values)-6], 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hrp, data, nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		expanded = append(expanded, byte(c>>5))
+	}
+	expanded = append(expanded, 0)
+	for _, c := range hrp {
+		expanded = append(expanded, byte(c&31))
+	}
+	return expanded
+}
+
+// convertBits regroups the data from fromBits bits per element into toBits bits per element
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var acc uint32
+	var bits uint
+	maxValue := uint32(1<<toBits) - 1
+	var converted []byte
+	for _, value := range data {
+		if uint32(value)>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid data range: %d", value)
+		}
+		acc = acc<<fromBits | uint32(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			converted = append(converted, byte(acc>>bits&maxValue))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			converted = append(converted, byte(acc<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxValue != 0 {
+		return nil, fmt.Errorf("invalid padding")
+	}
+	return converted, nil
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_DecodeBech32(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		for _, bech := range []string{
+			// test vectors from BIP-173
+			"A12UEL5L",
+			"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+			"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+			"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w",
+			// cosmos address
+			"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+		} {
+			_, _, err := DecodeBech32(bech)
+			require.NoError(t, err, bech)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, bech := range []string{
+			"pzry9x0s0muk",  // no separator
+			"1pzry9x0s0muk", // empty hrp
+			"x1b4n0q5v",     // invalid data character
+			"li1dgmt3",      // too short checksum
+			"A1G7SGD8",      // checksum calculated with uppercase hrp
+			"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xx", // bad checksum
+			"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5Lzv7xu", // mixed case
+		} {
+			_, _, err := DecodeBech32(bech)
+			require.Error(t, err, bech)
+		}
+	})
+
+	t.Run("decoded data", func(t *testing.T) {
+		hrp, data, err := DecodeBech32("cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu")
+		require.NoError(t, err)
+		require.Equal(t, "cosmos", hrp)
+		require.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, data)
+	})
+}
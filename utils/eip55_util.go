@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"encoding/hex"
+	"golang.org/x/crypto/sha3"
+	"regexp"
+	"strings"
+)
+
+// IsEvmAddressFormat returns true if the input is 0x followed by 40 hex characters, regardless of checksum
+func IsEvmAddressFormat(address string) bool {
+	return regexp.MustCompile(`^0x[a-fA-F\d]{40}$`).MatchString(address)
+}
+
+// ToEip55ChecksumAddress returns the EIP-55 mixed-case checksum form of the EVM address.
+// The input must satisfy IsEvmAddressFormat.
+func ToEip55ChecksumAddress(address string) string {
+	lowerHex := strings.ToLower(strings.TrimPrefix(address, "0x"))
+
+	hasher := sha3.NewLegacyKeccak256()
+	_, _ = hasher.Write([]byte(lowerHex))
+	hash := hex.EncodeToString(hasher.Sum(nil))
+
+	checksummed := []byte(lowerHex)
+	for i, c := range checksummed {
+		if c >= 'a' && c <= 'f' && hash[i] >= '8' {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(checksummed)
+}
+
+// IsEip55Checksummed returns true if the hex part of the EVM address has mixed case, means it carries a checksum
+func IsEip55Checksummed(address string) bool {
+	hexPart := strings.TrimPrefix(address, "0x")
+	return strings.ToLower(hexPart) != hexPart && strings.ToUpper(hexPart) != hexPart
+}
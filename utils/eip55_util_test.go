@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func Test_ToEip55ChecksumAddress(t *testing.T) {
+	// test vectors from EIP-55
+	for _, address := range []string{
+		"0x52908400098527886E0F7030069857D2E4169EE7",
+		"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+		"0xde709f2102306220921060314715629080e2fb77",
+		"0x27b1fdb04752bbc536007a920d24acb045561c26",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		t.Run(address, func(t *testing.T) {
+			require.True(t, IsEvmAddressFormat(address))
+			require.Equal(t, address, ToEip55ChecksumAddress(strings.ToLower(address)))
+		})
+	}
+}
+
+func Test_IsEvmAddressFormat(t *testing.T) {
+	require.False(t, IsEvmAddressFormat("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe"))
+	require.False(t, IsEvmAddressFormat("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAedd"))
+	require.False(t, IsEvmAddressFormat("5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	require.False(t, IsEvmAddressFormat("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeZ"))
+}
+
+func Test_IsEip55Checksummed(t *testing.T) {
+	require.True(t, IsEip55Checksummed("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	require.False(t, IsEip55Checksummed("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	require.False(t, IsEip55Checksummed("0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED"))
+}
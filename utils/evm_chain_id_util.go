@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+)
+
+// MaxSafeEvmChainId is the largest EVM chain id supported by common wallets (e.g. MetaMask),
+// so the EIP-155 signature V value (chainId * 2 + 36) fits in JavaScript safe integers.
+var MaxSafeEvmChainId = big.NewInt(4503599627370476)
+
+// ParseEvmChainId parses the EVM chain id given in hex form (0x prefixed) or decimal form.
+// Chain id must be positive, leading zeros are not allowed.
+func ParseEvmChainId(chainId string) (*big.Int, error) {
+	var digits string
+	var base int
+	var form string
+	if regexp.MustCompile(`^0x[a-fA-F\d]+$`).MatchString(chainId) {
+		digits, base, form = chainId[2:], 16, "hex"
+	} else if regexp.MustCompile(`^\d+$`).MatchString(chainId) {
+		digits, base, form = chainId, 10, "decimal"
+	} else {
+		return nil, fmt.Errorf("EVM chain id must be 0x followed by hexadecimal characters, or decimal number: %s", chainId)
+	}
+
+	if len(digits) > 1 && digits[0] == '0' {
+		return nil, fmt.Errorf("%s EVM chain id must not have leading zeros: %s", form, chainId)
+	}
+
+	value, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse %s EVM chain id: %s", form, chainId)
+	}
+	if value.Sign() <= 0 {
+		return nil, fmt.Errorf("EVM chain id must be positive: %s", chainId)
+	}
+
+	return value, nil
+}
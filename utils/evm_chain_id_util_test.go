@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_ParseEvmChainId(t *testing.T) {
+	tests := []struct {
+		chainId string
+		want    string
+		wantErr bool
+	}{
+		{chainId: "0x1", want: "1"},
+		{chainId: "0x44c", want: "1100"},
+		{chainId: "0x44C", want: "1100"},
+		{chainId: "1100", want: "1100"},
+		{chainId: "0xffffffffffffffffffffffffffffffff", want: "340282366920938463463374607431768211455"},
+		{chainId: "340282366920938463463374607431768211455", want: "340282366920938463463374607431768211455"},
+		{chainId: "", wantErr: true},
+		{chainId: "0x", wantErr: true},
+		{chainId: "0X1", wantErr: true},
+		{chainId: "0x0", wantErr: true},
+		{chainId: "0", wantErr: true},
+		{chainId: "0x01", wantErr: true},
+		{chainId: "01", wantErr: true},
+		{chainId: "-1", wantErr: true},
+		{chainId: "1.5", wantErr: true},
+		{chainId: "0xg", wantErr: true},
+		{chainId: " 1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chainId, func(t *testing.T) {
+			got, err := ParseEvmChainId(tt.chainId)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// IbcDenom returns the IBC voucher denom, ibc/<HASH>, of the base denom received over the port and channel
+func IbcDenom(port, channel, baseDenom string) string {
+	hash := sha256.Sum256([]byte(port + "/" + channel + "/" + baseDenom))
+	return "ibc/" + strings.ToUpper(hex.EncodeToString(hash[:]))
+}
@@ -0,0 +1,12 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_IbcDenom(t *testing.T) {
+	// ATOM on Osmosis
+	require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", IbcDenom("transfer", "channel-0", "uatom"))
+	require.NotEqual(t, IbcDenom("transfer", "channel-0", "uatom"), IbcDenom("transfer", "channel-1", "uatom"))
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"image"
+	"math/bits"
+)
+
+// AverageHash computes the 64 bits perceptual average hash of the image.
+// The image is reduced to 8x8 grayscale blocks, each bit is set if the block is brighter than the mean.
+func AverageHash(img image.Image) uint64 {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 {
+		return 0
+	}
+
+	var blocks [64]float64
+	var counts [64]float64
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		by := (y - bounds.Min.Y) * 8 / height
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			bx := (x - bounds.Min.X) * 8 / width
+			r, g, b, a := img.At(x, y).RGBA()
+			// composite over white background so transparent areas are treated as bright
+			alpha := float64(a) / 0xffff
+			luminance := (0.299*float64(r)+0.587*float64(g)+0.114*float64(b))/0xffff + (1 - alpha)
+			blocks[by*8+bx] += luminance
+			counts[by*8+bx]++
+		}
+	}
+
+	var mean float64
+	for i := range blocks {
+		if counts[i] > 0 {
+			blocks[i] /= counts[i]
+		}
+		mean += blocks[i]
+	}
+	mean /= 64
+
+	var hash uint64
+	for i, block := range blocks {
+		if block > mean {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// HammingDistance returns number of different bits between two hashes
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
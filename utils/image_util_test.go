@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+// newBlockPatternImage returns an image divided into 8x8 blocks, block i is white if bit i of the pattern is set, black otherwise
+func newBlockPatternImage(pattern uint64, size int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			block := (y*8/size)*8 + x*8/size
+			if pattern&(1<<uint(block)) != 0 {
+				img.Set(x, y, color.White)
+			} else {
+				img.Set(x, y, color.Black)
+			}
+		}
+	}
+	return img
+}
+
+func Test_AverageHash(t *testing.T) {
+	t.Run("hash reflects the block pattern", func(t *testing.T) {
+		for _, pattern := range []uint64{0x0F0F0F0F0F0F0F0F, 0x00000000FFFFFFFF, 0x8000000000000001} {
+			require.Equal(t, pattern, AverageHash(newBlockPatternImage(pattern, 64)))
+		}
+	})
+
+	t.Run("same image at different sizes", func(t *testing.T) {
+		const pattern = 0x3C3C3C3C00FF00FF
+		require.Equal(t, AverageHash(newBlockPatternImage(pattern, 64)), AverageHash(newBlockPatternImage(pattern, 512)))
+	})
+
+	t.Run("uniform image", func(t *testing.T) {
+		require.Zero(t, AverageHash(newBlockPatternImage(0, 64)))
+		require.Zero(t, AverageHash(newBlockPatternImage(^uint64(0), 64)))
+	})
+
+	t.Run("transparent area is treated as bright", func(t *testing.T) {
+		img := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+		for y := 0; y < 64; y++ {
+			for x := 0; x < 64; x++ {
+				if x < 32 {
+					img.Set(x, y, color.Black)
+				} // right half is fully transparent
+			}
+		}
+		require.Equal(t, AverageHash(newBlockPatternImage(0xF0F0F0F0F0F0F0F0, 64)), AverageHash(img))
+	})
+
+	t.Run("non-zero bounds origin", func(t *testing.T) {
+		const pattern = 0x0F0F0F0F0F0F0F0F
+		canvas := image.NewNRGBA(image.Rect(0, 0, 128, 128))
+		draw.Draw(canvas, image.Rect(64, 64, 128, 128), newBlockPatternImage(pattern, 64), image.Point{}, draw.Src)
+		require.Equal(t, uint64(pattern), AverageHash(canvas.SubImage(image.Rect(64, 64, 128, 128))))
+	})
+
+	t.Run("empty image", func(t *testing.T) {
+		require.Zero(t, AverageHash(image.NewNRGBA(image.Rect(0, 0, 0, 0))))
+	})
+}
+
+func Test_HammingDistance(t *testing.T) {
+	require.Equal(t, 0, HammingDistance(0x0F0F, 0x0F0F))
+	require.Equal(t, 1, HammingDistance(0x0F0F, 0x0F0E))
+	require.Equal(t, 64, HammingDistance(0, ^uint64(0)))
+}
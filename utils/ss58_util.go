@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"golang.org/x/crypto/blake2b"
+)
+
+// DecodeSS58 decodes the Substrate SS58 address of 32 bytes account id,
+// verifies its checksum and returns the network prefix and the account id.
+// See https://docs.substrate.io/reference/address-formats/
+func DecodeSS58(address string) (networkPrefix uint16, accountId []byte, err error) {
+	bz, err := DecodeBase58(address)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(bz) < 1 {
+		return 0, nil, fmt.Errorf("empty SS58 address")
+	}
+
+	var prefixLength int
+	switch {
+	case bz[0] < 64:
+		prefixLength = 1
+		networkPrefix = uint16(bz[0])
+	case bz[0] < 128:
+		if len(bz) < 2 {
+			return 0, nil, fmt.Errorf("SS58 address is too short")
+		}
+		prefixLength = 2
+		networkPrefix = uint16(bz[0]&0x3f)<<2 | uint16(bz[1]>>6) | uint16(bz[1]&0x3f)<<8
+	default:
+		return 0, nil, fmt.Errorf("SS58 address has reserved prefix byte %d", bz[0])
+	}
+
+	const accountIdLength = 32
+	const checksumLength = 2
+	if len(bz) != prefixLength+accountIdLength+checksumLength {
+		return 0, nil, fmt.Errorf("SS58 address must contain %d bytes account id, got %d bytes payload", accountIdLength, len(bz)-prefixLength-checksumLength)
+	}
+
+	payload := bz[:prefixLength+accountIdLength]
+	hash := blake2b.Sum512(append([]byte("SS58PRE"), payload...))
+	if !bytes.Equal(hash[:checksumLength], bz[prefixLength+accountIdLength:]) {
+		return 0, nil, fmt.Errorf("SS58 checksum mismatch")
+	}
+
+	return networkPrefix, bz[prefixLength : prefixLength+accountIdLength], nil
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_DecodeSS58(t *testing.T) {
+	//goland:noinspection SpellCheckingInspection
+	const (
+		aliceGeneric  = "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+		alicePolkadot = "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"
+		aliceKusama   = "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"
+	)
+
+	prefix, genericAccountId, err := DecodeSS58(aliceGeneric)
+	require.NoError(t, err)
+	require.Equal(t, uint16(42), prefix)
+	require.Len(t, genericAccountId, 32)
+
+	prefix, accountId, err := DecodeSS58(alicePolkadot)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0), prefix)
+	require.Equal(t, genericAccountId, accountId)
+
+	prefix, accountId, err = DecodeSS58(aliceKusama)
+	require.NoError(t, err)
+	require.Equal(t, uint16(2), prefix)
+	require.Equal(t, genericAccountId, accountId)
+
+	//goland:noinspection SpellCheckingInspection
+	for _, bad := range []string{
+		"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ", // typo, checksum mismatch
+		"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQ",  // truncated
+		"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKut0Y", // invalid base58 character
+	} {
+		_, _, err := DecodeSS58(bad)
+		require.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"golang.org/x/text/unicode/norm"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// confusableRunes maps characters to the ASCII character they are visually confusable with.
+// It is a subset of the Unicode confusables data (UTS #39), covering Greek, Cyrillic and ASCII look-alikes
+// which are commonly used to spoof chain names and tickers.
+var confusableRunes = map[rune]rune{
+	// ASCII
+	'0': 'o', 'O': 'o', '1': 'l', 'I': 'l', '|': 'l',
+	// Latin
+	'ı': 'i', 'ℓ': 'l', 'ǀ': 'l', 'ɡ': 'g',
+	// Greek
+	'Α': 'a', 'Β': 'b', 'Ε': 'e', 'Ζ': 'z', 'Η': 'h', 'Ι': 'l', 'Κ': 'k', 'Μ': 'm', 'Ν': 'n', 'Ο': 'o',
+	'Ρ': 'p', 'Τ': 't', 'Υ': 'y', 'Χ': 'x', 'α': 'a', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p',
+	'υ': 'u', 'χ': 'x', 'ϲ': 'c', 'ϳ': 'j',
+	// Cyrillic
+	'А': 'a', 'В': 'b', 'Е': 'e', 'К': 'k', 'М': 'm', 'Н': 'h', 'О': 'o', 'Р': 'p', 'С': 'c', 'Т': 't',
+	'У': 'y', 'Х': 'x', 'І': 'l', 'Ј': 'j', 'Ѕ': 's', 'Ԁ': 'd', 'Ԛ': 'q', 'Ԝ': 'w', 'Ӏ': 'l',
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x', 'і': 'i', 'ј': 'j', 'ѕ': 's',
+	'ԁ': 'd', 'һ': 'h', 'ԛ': 'q', 'ԝ': 'w', 'ӏ': 'l', 'ү': 'y',
+}
+
+// confusableSequences are multi-character ASCII sequences which are visually confusable with a single character
+var confusableSequences = strings.NewReplacer("rn", "m", "vv", "w")
+
+// ConfusableSkeleton returns a normalized form of the text, two texts having the same skeleton are visually confusable.
+// The text is NFKC normalized, confusable characters are mapped to their ASCII look-alike and letter case is ignored.
+func ConfusableSkeleton(text string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFKC.String(text) {
+		if mapped, found := confusableRunes[r]; found {
+			r = mapped
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return confusableSequences.Replace(sb.String())
+}
+
+// IsNFC returns true if the text is in Unicode Normalization Form C
+func IsNFC(text string) bool {
+	return norm.NFC.IsNormalString(text)
+}
+
+// Scripts returns the sorted names of the Unicode scripts used by the letters of the text,
+// characters which are common to multiple scripts (digits, punctuation,...) are ignored.
+func Scripts(text string) []string {
+	uniqueScripts := make(map[string]bool)
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		for name, table := range unicode.Scripts {
+			if name == "Common" || name == "Inherited" {
+				continue
+			}
+			if unicode.Is(table, r) {
+				uniqueScripts[name] = true
+				break
+			}
+		}
+	}
+
+	scripts := make([]string, 0, len(uniqueScripts))
+	for script := range uniqueScripts {
+		scripts = append(scripts, script)
+	}
+	sort.Strings(scripts)
+	return scripts
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_ConfusableSkeleton(t *testing.T) {
+	tests := []struct {
+		a          string
+		b          string
+		confusable bool
+	}{
+		{a: "DYM", b: "DYΜ", confusable: true},             // Greek Mu
+		{a: "Dymension", b: "Dymensіon", confusable: true}, // Cyrillic i
+		{a: "USDC", b: "USDC", confusable: true},
+		{a: "OSMO", b: "0SM0", confusable: true},
+		{a: "modern", b: "modem", confusable: true},
+		{a: "ATOM", b: "atom", confusable: true},
+		{a: "USDC", b: "USDT", confusable: false},
+		{a: "DYM", b: "DYN", confusable: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			require.Equal(t, tt.confusable, ConfusableSkeleton(tt.a) == ConfusableSkeleton(tt.b))
+		})
+	}
+}
+
+func Test_Scripts(t *testing.T) {
+	require.Equal(t, []string{"Latin"}, Scripts("Dymension Hub 2"))
+	require.Equal(t, []string{"Cyrillic", "Latin"}, Scripts("Dymensіon"))
+	require.Equal(t, []string{"Greek", "Latin"}, Scripts("DYΜ"))
+	require.Empty(t, Scripts("123 - 456"))
+}
+
+func Test_IsNFC(t *testing.T) {
+	require.True(t, IsNFC("Café"))
+	require.False(t, IsNFC("Café"))
+}
@@ -0,0 +1,51 @@
+package wellknown
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// Bech32PrefixOwner is a well-known chain which owns the bech32 prefix
+type Bech32PrefixOwner struct {
+	Prefix    string `json:"prefix"`
+	ChainName string `json:"chainName"`
+
+	// ChainIdPrefixes are the chain ids without EVM chain id and revision, of the mainnet and testnets of the owner
+	ChainIdPrefixes []string `json:"chainIdPrefixes"`
+}
+
+// bech32PrefixesJson is a list of bech32 prefixes which are well-known to be owned by chains
+//
+//go:embed bech32_prefixes.json
+var bech32PrefixesJson []byte
+
+var bech32PrefixOwners map[string]Bech32PrefixOwner
+
+func init() {
+	var owners []Bech32PrefixOwner
+	if err := json.Unmarshal(bech32PrefixesJson, &owners); err != nil {
+		panic(err)
+	}
+
+	bech32PrefixOwners = make(map[string]Bech32PrefixOwner, len(owners))
+	for _, owner := range owners {
+		bech32PrefixOwners[owner.Prefix] = owner
+	}
+}
+
+// LookupBech32PrefixOwner returns the well-known chain which owns the bech32 prefix
+func LookupBech32PrefixOwner(prefix string) (Bech32PrefixOwner, bool) {
+	owner, found := bech32PrefixOwners[prefix]
+	return owner, found
+}
+
+// IsOwnerChainIdPrefix returns true if the chain id prefix, the chain id without EVM chain id and revision,
+// exactly matches one of the chain id prefixes of the owner of the bech32 prefix.
+func (o Bech32PrefixOwner) IsOwnerChainIdPrefix(chainIdPrefix string) bool {
+	for _, ownerChainIdPrefix := range o.ChainIdPrefixes {
+		if chainIdPrefix == ownerChainIdPrefix {
+			return true
+		}
+	}
+	return false
+}
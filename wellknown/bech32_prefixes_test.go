@@ -0,0 +1,48 @@
+package wellknown
+
+import (
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func Test_LookupBech32PrefixOwner(t *testing.T) {
+	owner, found := LookupBech32PrefixOwner("dym")
+	require.True(t, found)
+	require.True(t, owner.IsOwnerChainIdPrefix("dymension"))
+	require.True(t, owner.IsOwnerChainIdPrefix("blumbus"))
+	require.False(t, owner.IsOwnerChainIdPrefix("rollappx"))
+	require.False(t, owner.IsOwnerChainIdPrefix("dymensionfake"), "lookalike chain id prefix must not be treated as owner")
+	require.False(t, owner.IsOwnerChainIdPrefix("froopylandx"), "lookalike chain id prefix must not be treated as owner")
+	require.False(t, owner.IsOwnerChainIdPrefix("dymension_1100-1"), "chain id must be parsed by caller")
+
+	_, found = LookupBech32PrefixOwner("rollappx")
+	require.False(t, found)
+}
+
+func Test_Bech32PrefixOwner_IsOwnerChainIdPrefix_ShortPrefix(t *testing.T) {
+	owner, found := LookupBech32PrefixOwner("juno")
+	require.True(t, found)
+	require.True(t, owner.IsOwnerChainIdPrefix("uni"))
+	require.True(t, owner.IsOwnerChainIdPrefix("juno"))
+	require.False(t, owner.IsOwnerChainIdPrefix("universe"), "short chain id prefix must not match longer names")
+	require.False(t, owner.IsOwnerChainIdPrefix("unicorn"), "short chain id prefix must not match longer names")
+
+	owner, found = LookupBech32PrefixOwner("persistence")
+	require.True(t, found)
+	require.True(t, owner.IsOwnerChainIdPrefix("core"))
+	require.True(t, owner.IsOwnerChainIdPrefix("test-core"))
+	require.False(t, owner.IsOwnerChainIdPrefix("coreum-mainnet"))
+}
+
+func Test_Bech32PrefixOwners_ChainIdPrefixes(t *testing.T) {
+	// chain id prefixes are compared exactly with the chain id without EVM chain id and revision,
+	// so they must not have those parts
+	hasEvmChainIdOrRevision := regexp.MustCompile(`(_\d+)?-\d+$`)
+	for _, owner := range bech32PrefixOwners {
+		for _, chainIdPrefix := range owner.ChainIdPrefixes {
+			require.NotEmpty(t, chainIdPrefix, owner.Prefix)
+			require.False(t, hasEvmChainIdOrRevision.MatchString(chainIdPrefix), "chain id prefix %s of %s", chainIdPrefix, owner.Prefix)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package wellknown
+
+import (
+	_ "embed"
+	"encoding/json"
+	"math/big"
+)
+
+// EvmChain is a well-known public EVM chain, registered at https://chainlist.org
+type EvmChain struct {
+	ChainId int64  `json:"chainId"`
+	Name    string `json:"name"`
+
+	// ChainIdPrefixes are the prefixes of chain ids used by the chain in the registry
+	ChainIdPrefixes []string `json:"chainIdPrefixes"`
+
+	// ReusedByChainIdPrefixes are the chain id prefixes of other well-known chains which use the same EVM chain id,
+	// e.g. Dymension testnet Froopyland (froopyland_100-1) uses EVM chain id 100 of Gnosis.
+	ReusedByChainIdPrefixes []string `json:"reusedByChainIdPrefixes,omitempty"`
+}
+
+// evmChainIdsJson is a curated subset of the EVM chain ids registered at chainlist,
+// holding the chains whose EVM chain id is likely to be reused by mistake. It is not a full export of chainlist.
+//
+//go:embed evm_chain_ids.json
+var evmChainIdsJson []byte
+
+var evmChains map[int64]EvmChain
+
+func init() {
+	var chains []EvmChain
+	if err := json.Unmarshal(evmChainIdsJson, &chains); err != nil {
+		panic(err)
+	}
+
+	evmChains = make(map[int64]EvmChain, len(chains))
+	for _, chain := range chains {
+		evmChains[chain.ChainId] = chain
+	}
+}
+
+// LookupEvmChain returns the well-known EVM chain which uses the EVM chain id
+func LookupEvmChain(evmChainId *big.Int) (EvmChain, bool) {
+	if !evmChainId.IsInt64() {
+		return EvmChain{}, false
+	}
+	chain, found := evmChains[evmChainId.Int64()]
+	return chain, found
+}
+
+// IsOwnerChainIdPrefix returns true if the chain id prefix, the chain id without EVM chain id and revision,
+// exactly matches one of the chain id prefixes of the well-known EVM chain.
+func (c EvmChain) IsOwnerChainIdPrefix(chainIdPrefix string) bool {
+	for _, ownerChainIdPrefix := range c.ChainIdPrefixes {
+		if chainIdPrefix == ownerChainIdPrefix {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowedChainIdPrefix returns true if the chain id prefix belongs to the well-known EVM chain,
+// or to a well-known chain which is known to reuse its EVM chain id.
+func (c EvmChain) IsAllowedChainIdPrefix(chainIdPrefix string) bool {
+	if c.IsOwnerChainIdPrefix(chainIdPrefix) {
+		return true
+	}
+	for _, reusedByChainIdPrefix := range c.ReusedByChainIdPrefixes {
+		if chainIdPrefix == reusedByChainIdPrefix {
+			return true
+		}
+	}
+	return false
+}
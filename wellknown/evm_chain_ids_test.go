@@ -0,0 +1,53 @@
+package wellknown
+
+import (
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"regexp"
+	"testing"
+)
+
+func Test_LookupEvmChain(t *testing.T) {
+	chain, found := LookupEvmChain(big.NewInt(1))
+	require.True(t, found)
+	require.Equal(t, "Ethereum Mainnet", chain.Name)
+	require.True(t, chain.IsOwnerChainIdPrefix("ethereum"))
+	require.False(t, chain.IsOwnerChainIdPrefix("foo"))
+
+	chain, found = LookupEvmChain(big.NewInt(1100))
+	require.True(t, found)
+	require.True(t, chain.IsOwnerChainIdPrefix("dymension"))
+	require.False(t, chain.IsOwnerChainIdPrefix("dymensionx"), "lookalike chain id prefix must not be treated as owner")
+
+	chain, found = LookupEvmChain(big.NewInt(8453))
+	require.True(t, found)
+	require.False(t, chain.IsOwnerChainIdPrefix("basecamp"), "lookalike chain id prefix must not be treated as owner")
+	require.False(t, chain.IsOwnerChainIdPrefix("base-sepolia"), "lookalike chain id prefix must not be treated as owner")
+
+	chain, found = LookupEvmChain(big.NewInt(100))
+	require.True(t, found)
+	require.True(t, chain.IsAllowedChainIdPrefix("gnosis"))
+	require.False(t, chain.IsOwnerChainIdPrefix("froopyland"))
+	require.True(t, chain.IsAllowedChainIdPrefix("froopyland"), "Dymension testnet Froopyland reuses EVM chain id of Gnosis")
+	require.False(t, chain.IsAllowedChainIdPrefix("froopylandx"))
+
+	_, found = LookupEvmChain(big.NewInt(123456789123))
+	require.False(t, found)
+
+	tooLarge, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+	_, found = LookupEvmChain(tooLarge)
+	require.False(t, found)
+}
+
+func Test_EvmChainIdsSnapshot(t *testing.T) {
+	for chainId, chain := range evmChains {
+		require.Positive(t, chainId)
+		require.NotEmpty(t, chain.Name)
+		require.NotEmpty(t, chain.ChainIdPrefixes, chain.Name)
+		// chain id prefixes are compared exactly with the chain id without EVM chain id and revision,
+		// so they must not have those parts
+		for _, chainIdPrefix := range append(chain.ChainIdPrefixes, chain.ReusedByChainIdPrefixes...) {
+			require.False(t, regexp.MustCompile(`(_\d+)?-\d+$`).MatchString(chainIdPrefix), "chain id prefix %s of %s", chainIdPrefix, chain.Name)
+		}
+	}
+}
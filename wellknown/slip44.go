@@ -0,0 +1,41 @@
+package wellknown
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// Slip44CoinType is a coin type registered in SLIP-0044,
+// see https://github.com/satoshilabs/slips/blob/master/slip-0044.md
+type Slip44CoinType struct {
+	CoinType int64  `json:"coinType"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+}
+
+// slip44Json is a curated subset of SLIP-0044 registered coin types, covering networks commonly seen
+// in the Cosmos and EVM ecosystems. It is not the full registry, coin types which are not found are not
+// necessarily unregistered.
+//
+//go:embed slip44.json
+var slip44Json []byte
+
+var slip44CoinTypes map[int64]Slip44CoinType
+
+func init() {
+	var coinTypes []Slip44CoinType
+	if err := json.Unmarshal(slip44Json, &coinTypes); err != nil {
+		panic(err)
+	}
+
+	slip44CoinTypes = make(map[int64]Slip44CoinType, len(coinTypes))
+	for _, coinType := range coinTypes {
+		slip44CoinTypes[coinType.CoinType] = coinType
+	}
+}
+
+// LookupSlip44CoinType returns the registration of the coin type from the embedded curated subset of SLIP-0044
+func LookupSlip44CoinType(coinType int64) (Slip44CoinType, bool) {
+	registered, found := slip44CoinTypes[coinType]
+	return registered, found
+}
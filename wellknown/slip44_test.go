@@ -0,0 +1,19 @@
+package wellknown
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_LookupSlip44CoinType(t *testing.T) {
+	registered, found := LookupSlip44CoinType(118)
+	require.True(t, found)
+	require.Equal(t, "ATOM", registered.Symbol)
+
+	registered, found = LookupSlip44CoinType(501)
+	require.True(t, found)
+	require.Equal(t, "Solana", registered.Name)
+
+	_, found = LookupSlip44CoinType(123456789)
+	require.False(t, found)
+}